@@ -0,0 +1,98 @@
+package kreuzberg
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// BatchItemResult is the outcome of one input of a batch extraction. Exactly one
+// of Result and Err is non-nil.
+type BatchItemResult struct {
+	Result *ExtractionResult
+	Err    error
+}
+
+// BatchExtractFilesItems extracts paths like BatchExtractFilesSync but reports
+// each input separately, so the successes of a batch can be processed when some
+// inputs fail:
+//
+//	for i, item := range kreuzberg.BatchExtractFilesItems(paths, config) {
+//		if item.Err != nil {
+//			log.Printf("%s: %v", paths[i], item.Err)
+//			continue
+//		}
+//		index(item.Result)
+//	}
+//
+// The returned slice is parallel to paths. Inputs the native core reports as
+// failed, through a nil result or Metadata.Error, and results rejected by a
+// ContentValidator get their own Err. If the native batch call fails as a whole,
+// the inputs are extracted one at a time with ExtractFileSync instead. Errors
+// that concern every input, such as an invalid config, are reported for each.
+// If config.OnComplete is set, it is called once per input with the same outcome.
+func BatchExtractFilesItems(paths []string, config *ExtractionConfig) []BatchItemResult {
+	items := make([]BatchItemResult, len(paths))
+	if len(paths) == 0 {
+		return items
+	}
+
+	results, err := batchExtractFilesRaw(context.Background(), paths, config)
+	if err != nil {
+		for i, path := range paths {
+			items[i].Result, items[i].Err = ExtractFileSync(path, config)
+		}
+	} else {
+		finalizeBatchItems(items, results, config)
+	}
+
+	if config != nil && config.OnComplete != nil {
+		for i, path := range paths {
+			config.OnComplete(path, items[i].Result, items[i].Err)
+		}
+	}
+	return items
+}
+
+// BatchExtractBytesItems extracts in-memory documents like BatchExtractBytesSync
+// but reports each input separately; see BatchExtractFilesItems. When the native
+// batch call fails as a whole, the inputs are extracted one at a time with
+// ExtractBytesSync.
+func BatchExtractBytesItems(inputs []BytesWithMime, config *ExtractionConfig) []BatchItemResult {
+	items := make([]BatchItemResult, len(inputs))
+	if len(inputs) == 0 {
+		return items
+	}
+
+	results, err := batchExtractBytesRaw(context.Background(), inputs, config)
+	if err != nil {
+		for i, input := range inputs {
+			items[i].Result, items[i].Err = ExtractBytesSync(input.Data, input.MimeType, config)
+		}
+	} else {
+		finalizeBatchItems(items, results, config)
+	}
+
+	if config != nil && config.OnComplete != nil {
+		for i := range inputs {
+			config.OnComplete(strconv.Itoa(i), items[i].Result, items[i].Err)
+		}
+	}
+	return items
+}
+
+// finalizeBatchItems fills items from the raw results of a native batch call,
+// turning failed inputs into errors and applying Go-side options to the rest.
+func finalizeBatchItems(items []BatchItemResult, results []*ExtractionResult, config *ExtractionConfig) {
+	for i := range items {
+		if i >= len(results) || results[i] == nil {
+			items[i].Err = newRuntimeErrorWithContext(fmt.Sprintf("no result for batch input %d", i), nil, ErrorCodeInternal, nil)
+			continue
+		}
+		if failure := results[i].Metadata.Error; failure != nil {
+			items[i].Err = newRuntimeErrorWithContext(fmt.Sprintf("%s: %s", failure.ErrorType, failure.Message), nil, ErrorCodeInternal, nil)
+			continue
+		}
+		items[i].Result, items[i].Err = finalizeResult(results[i], config)
+	}
+}
@@ -0,0 +1,66 @@
+package kreuzberg
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFinalizeBatchItems(t *testing.T) {
+	errRejected := errors.New("too short")
+	config := NewExtractionConfig(WithContentValidator(func(result *ExtractionResult) error {
+		if len(result.Content) < 5 {
+			return errRejected
+		}
+		return nil
+	}))
+	results := []*ExtractionResult{
+		{Content: "first document"},
+		{Content: "Error: corrupt", Metadata: Metadata{Error: &ErrorMetadata{ErrorType: "ParsingError", Message: "corrupt xref table"}}},
+		nil,
+		{Content: "tiny"},
+	}
+
+	items := make([]BatchItemResult, len(results)+1)
+	finalizeBatchItems(items, results, config)
+
+	if items[0].Err != nil || items[0].Result == nil || items[0].Result.Content != "first document" {
+		t.Errorf("expected first input to succeed, got %+v", items[0])
+	}
+	if items[1].Result != nil || items[1].Err == nil {
+		t.Errorf("expected native failure to become an error, got %+v", items[1])
+	}
+	for _, i := range []int{2, 4} {
+		if items[i].Result != nil || items[i].Err == nil {
+			t.Errorf("expected missing result %d to become an error, got %+v", i, items[i])
+		}
+	}
+	if !errors.Is(items[3].Err, errRejected) || items[3].Result != nil {
+		t.Errorf("expected validator rejection for input 3 only, got %+v", items[3])
+	}
+}
+
+func TestBatchExtractFilesItems_PerInputErrors(t *testing.T) {
+	notified := 0
+	config := NewExtractionConfig(WithOnComplete(func(string, *ExtractionResult, error) { notified++ }))
+
+	items := BatchExtractFilesItems([]string{"missing-1.pdf", ""}, config)
+	if len(items) != 2 {
+		t.Fatalf("expected one item per path, got %d", len(items))
+	}
+	for i, item := range items {
+		if item.Err == nil || item.Result != nil {
+			t.Errorf("item %d: expected an error and no result, got %+v", i, item)
+		}
+	}
+	var validationErr *ValidationError
+	if !errors.As(items[1].Err, &validationErr) {
+		t.Errorf("expected empty path to be a validation error, got %v", items[1].Err)
+	}
+	if notified != 2 {
+		t.Errorf("expected OnComplete once per input, got %d", notified)
+	}
+
+	if items := BatchExtractBytesItems(nil, nil); len(items) != 0 {
+		t.Errorf("expected no items for empty input, got %d", len(items))
+	}
+}
@@ -0,0 +1,218 @@
+package kreuzberg
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"runtime"
+	"sync"
+)
+
+// BatchFileResult is one value yielded by BatchExtractFilesSeq.
+type BatchFileResult struct {
+	// Index is the position of Path in the paths passed to BatchExtractFilesSeq,
+	// or -1 for an error that concerns every path, such as an invalid config.
+	Index int
+	// Path is the extracted file, or empty when Index is -1.
+	Path string
+	// Result is the extraction result, or nil when the file failed.
+	Result *ExtractionResult
+}
+
+// batchItem carries one completed extraction from a worker to the iterator.
+type batchItem struct {
+	result BatchFileResult
+	err    error
+}
+
+// BatchExtractFilesSeq returns an iterator that extracts paths concurrently and
+// yields each result as soon as it completes:
+//
+//	for item, err := range kreuzberg.BatchExtractFilesSeq(ctx, paths, config) {
+//		if err != nil {
+//			log.Println(err)
+//			continue
+//		}
+//		index(item.Path, item.Result)
+//	}
+//
+// Every path yields exactly one value, carrying its Index and Path, in completion
+// order rather than input order; errors are prefixed with the failing path. An
+// invalid config is instead reported by a single value with Index -1. At most
+// config.MaxConcurrentExtractions files (default runtime.NumCPU()) are in flight
+// at once. Native extraction calls are still serialized, so concurrency mainly
+// overlaps Go-side pre- and post-processing.
+//
+// If config.OnComplete is set, it is called once for every path, including paths
+// that were never started because the loop ended early or ctx was cancelled.
+//
+// Breaking out of the loop or cancelling ctx stops scheduling new files; the loop
+// returns once files already in flight have finished. Paths that were not started
+// because ctx was cancelled, and files whose native call was in flight, yield
+// ctx.Err().
+func BatchExtractFilesSeq(ctx context.Context, paths []string, config *ExtractionConfig) iter.Seq2[BatchFileResult, error] {
+	return func(yield func(BatchFileResult, error) bool) {
+		if len(paths) == 0 {
+			return
+		}
+		notify := serializedOnComplete(config)
+
+		if err := validateConfigBeforeFFI(config); err != nil {
+			for _, path := range paths {
+				notify(path, nil, err)
+			}
+			yield(BatchFileResult{Index: -1}, err)
+			return
+		}
+
+		workerCtx, cancel := context.WithCancel(ctx)
+
+		limit := batchConcurrency(config)
+
+		// Sends on items never block for good: the loop below receives until
+		// items is closed or, once it returns, the deferred drain does.
+		items := make(chan batchItem)
+		go func() {
+			var wg sync.WaitGroup
+			sem := make(chan struct{}, limit)
+			scheduled := 0
+		schedule:
+			for _, path := range paths {
+				select {
+				case sem <- struct{}{}:
+				case <-workerCtx.Done():
+					break schedule
+				}
+				wg.Add(1)
+				go func(index int, path string) {
+					defer wg.Done()
+					defer func() { <-sem }()
+
+					result, err := extractFile(workerCtx, path, config)
+					notify(path, result, err)
+					if err != nil {
+						err = fmt.Errorf("%s: %w", path, err)
+					}
+					items <- batchItem{result: BatchFileResult{Index: index, Path: path, Result: result}, err: err}
+				}(scheduled, path)
+				scheduled++
+			}
+			for i := scheduled; i < len(paths); i++ {
+				err := workerCtx.Err()
+				notify(paths[i], nil, err)
+				items <- batchItem{result: BatchFileResult{Index: i, Path: paths[i]}, err: fmt.Errorf("%s: %w", paths[i], err)}
+			}
+			wg.Wait()
+			close(items)
+		}()
+		// Wait for in-flight extractions so no work or callbacks outlive the loop.
+		defer func() {
+			cancel()
+			for range items {
+			}
+		}()
+
+		for item := range items {
+			if !yield(item.result, item.err) {
+				return
+			}
+		}
+	}
+}
+
+// BatchExtractFilesPool extracts paths with a fixed pool of workers goroutines
+// and returns the results and errors as slices parallel to paths: results[i] is
+// nil exactly when errs[i] is non-nil, so one bad file does not fail the batch.
+//
+//	results, errs := kreuzberg.BatchExtractFilesPool(ctx, paths, config, 8)
+//	for i, result := range results {
+//		if errs[i] != nil {
+//			log.Printf("%s: %v", paths[i], errs[i])
+//			continue
+//		}
+//		index(result)
+//	}
+//
+// When workers is less than 1, config.MaxConcurrentExtractions (default
+// runtime.NumCPU()) is used; no more workers than paths are started. Native
+// extraction calls are still serialized, so workers mainly overlap Go-side pre-
+// and post-processing. If config.OnComplete is set, it is called once for every
+// path. Cancelling ctx stops handing out files; paths that were not started or
+// were in flight get ctx.Err(), and the call returns once the native calls in
+// flight have finished.
+func BatchExtractFilesPool(ctx context.Context, paths []string, config *ExtractionConfig, workers int) ([]*ExtractionResult, []error) {
+	results := make([]*ExtractionResult, len(paths))
+	errs := make([]error, len(paths))
+	if len(paths) == 0 {
+		return results, errs
+	}
+	notify := serializedOnComplete(config)
+
+	if err := validateConfigBeforeFFI(config); err != nil {
+		for i, path := range paths {
+			errs[i] = err
+			notify(path, nil, err)
+		}
+		return results, errs
+	}
+
+	if workers < 1 {
+		workers = batchConcurrency(config)
+	}
+	workers = min(workers, len(paths))
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				results[i], errs[i] = extractFile(ctx, paths[i], config)
+				notify(paths[i], results[i], errs[i])
+			}
+		}()
+	}
+
+	scheduled := 0
+schedule:
+	for i := range paths {
+		select {
+		case indexes <- i:
+			scheduled++
+		case <-ctx.Done():
+			break schedule
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	for i := scheduled; i < len(paths); i++ {
+		errs[i] = ctx.Err()
+		notify(paths[i], nil, errs[i])
+	}
+	return results, errs
+}
+
+// serializedOnComplete returns a function that forwards to config.OnComplete one
+// call at a time, or does nothing when no callback is set.
+func serializedOnComplete(config *ExtractionConfig) CompletionCallback {
+	if config == nil || config.OnComplete == nil {
+		return func(string, *ExtractionResult, error) {}
+	}
+	var mu sync.Mutex
+	return func(path string, result *ExtractionResult, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		config.OnComplete(path, result, err)
+	}
+}
+
+// batchConcurrency returns the number of files the concurrent batch APIs extract
+// at once: config.MaxConcurrentExtractions, or runtime.NumCPU() when unset.
+func batchConcurrency(config *ExtractionConfig) int {
+	if config != nil && config.MaxConcurrentExtractions != nil && *config.MaxConcurrentExtractions > 0 {
+		return *config.MaxConcurrentExtractions
+	}
+	return runtime.NumCPU()
+}
@@ -0,0 +1,138 @@
+package kreuzberg
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestBatchExtractFilesSeq_Empty(t *testing.T) {
+	for range BatchExtractFilesSeq(context.Background(), nil, nil) {
+		t.Fatal("expected no results for empty input")
+	}
+}
+
+func TestBatchExtractFilesSeq_InvalidConfig(t *testing.T) {
+	config := NewExtractionConfig(WithPdfOptions(WithColumnGapThreshold(5)))
+
+	calls := 0
+	for item, err := range BatchExtractFilesSeq(context.Background(), []string{"a.pdf", "b.pdf"}, config) {
+		calls++
+		if item.Result != nil || item.Index != -1 {
+			t.Errorf("expected no result and index -1 for an invalid config, got %+v", item)
+		}
+		if _, ok := err.(*ValidationError); !ok {
+			t.Errorf("expected ValidationError, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected a single validation error, got %d values", calls)
+	}
+}
+
+func TestBatchExtractFilesSeq_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	paths := []string{"a.pdf", "b.pdf", "c.pdf"}
+	seen := map[int]string{}
+	for item, err := range BatchExtractFilesSeq(ctx, paths, NewExtractionConfig(WithMaxConcurrentExtractions(1))) {
+		if item.Result != nil || !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v, %v", item.Result, err)
+		}
+		seen[item.Index] = item.Path
+	}
+	for i, path := range paths {
+		if seen[i] != path {
+			t.Errorf("expected one value for %s at index %d, got %v", path, i, seen)
+		}
+	}
+}
+
+func TestBatchExtractFilesSeq_EarlyBreak(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	for range BatchExtractFilesSeq(ctx, []string{"a.pdf", "b.pdf", "c.pdf"}, nil) {
+		calls++
+		break
+	}
+	if calls != 1 {
+		t.Errorf("expected iteration to stop after break, got %d values", calls)
+	}
+}
+
+func TestBatchExtractFilesSeq_OnCompleteOncePerPath(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	paths := []string{"a.pdf", "b.pdf", "c.pdf", "d.pdf"}
+	seen := map[string]int{}
+	config := NewExtractionConfig(
+		WithMaxConcurrentExtractions(2),
+		WithOnComplete(func(path string, result *ExtractionResult, err error) {
+			seen[path]++
+			if result != nil || !errors.Is(err, context.Canceled) {
+				t.Errorf("unexpected completion for %s: %v, %v", path, result, err)
+			}
+		}),
+	)
+
+	for range BatchExtractFilesSeq(ctx, paths, config) {
+		break
+	}
+	for _, path := range paths {
+		if seen[path] != 1 {
+			t.Errorf("expected exactly one callback for %s, got %d", path, seen[path])
+		}
+	}
+}
+
+func TestBatchExtractFilesPool_InvalidConfig(t *testing.T) {
+	var notified []string
+	config := NewExtractionConfig(
+		WithPdfOptions(WithColumnGapThreshold(5)),
+		WithOnComplete(func(path string, _ *ExtractionResult, _ error) { notified = append(notified, path) }),
+	)
+
+	results, errs := BatchExtractFilesPool(context.Background(), []string{"a.pdf", "b.pdf"}, config, 4)
+	if len(results) != 2 || len(errs) != 2 {
+		t.Fatalf("expected slices parallel to paths, got %d results and %d errors", len(results), len(errs))
+	}
+	for i, err := range errs {
+		if _, ok := err.(*ValidationError); !ok || results[i] != nil {
+			t.Errorf("path %d: expected ValidationError and no result, got %v", i, err)
+		}
+	}
+	if len(notified) != 2 {
+		t.Errorf("expected OnComplete for every path, got %v", notified)
+	}
+}
+
+func TestBatchExtractFilesPool_PerFileErrors(t *testing.T) {
+	paths := []string{"missing-1.pdf", "missing-2.pdf", "missing-3.pdf"}
+	results, errs := BatchExtractFilesPool(context.Background(), paths, nil, 2)
+	for i := range paths {
+		if errs[i] == nil || results[i] != nil {
+			t.Errorf("path %d: expected an error and no result, got %v, %v", i, results[i], errs[i])
+		}
+	}
+}
+
+func TestBatchExtractFilesPool_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	config := NewExtractionConfig(WithOnComplete(func(string, *ExtractionResult, error) { calls++ }))
+	_, errs := BatchExtractFilesPool(ctx, []string{"a.pdf", "b.pdf", "c.pdf"}, config, 0)
+	for i, err := range errs {
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("path %d: expected context.Canceled, got %v", i, err)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("expected OnComplete for every path, got %d calls", calls)
+	}
+}
@@ -0,0 +1,175 @@
+package kreuzberg
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// unknownMimeType groups failed inputs whose MIME type could not be determined.
+const unknownMimeType = "unknown"
+
+// MimeTypeStats aggregates the outcomes of the extractions of one MIME type.
+type MimeTypeStats struct {
+	// MimeType is the MIME type the statistics are for.
+	MimeType string `json:"mime_type"`
+	// Count is the number of inputs recorded.
+	Count int `json:"count"`
+	// Succeeded is the number of inputs extracted without error.
+	Succeeded int `json:"succeeded"`
+	// Failed is the number of inputs whose extraction failed.
+	Failed int `json:"failed"`
+	// TotalDuration is the sum of the durations of the successful results: the
+	// native result.Metrics.TotalDuration, or result.ElapsedTime when the core
+	// reported no metrics.
+	TotalDuration time.Duration `json:"total_duration_ns"`
+	// TimedCount is the number of successful results that reported a duration.
+	TimedCount int `json:"timed_count"`
+	// TotalPages is the sum of the page counts of the successful results.
+	TotalPages uint64 `json:"total_pages"`
+}
+
+// SuccessRate returns the fraction (0.0-1.0) of inputs extracted without error.
+func (s *MimeTypeStats) SuccessRate() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Succeeded) / float64(s.Count)
+}
+
+// AverageDuration returns the mean extraction time of the timed results, or 0
+// when none were timed. See TotalDuration for where the durations come from.
+func (s *MimeTypeStats) AverageDuration() time.Duration {
+	if s.TimedCount == 0 {
+		return 0
+	}
+	return s.TotalDuration / time.Duration(s.TimedCount)
+}
+
+// AveragePageCount returns the mean page count of the successful results.
+func (s *MimeTypeStats) AveragePageCount() float64 {
+	if s.Succeeded == 0 {
+		return 0
+	}
+	return float64(s.TotalPages) / float64(s.Succeeded)
+}
+
+// BatchStats summarizes a batch extraction run per MIME type.
+type BatchStats struct {
+	// Total aggregates all inputs; its MimeType is empty.
+	Total MimeTypeStats `json:"total"`
+	// ByMimeType holds one entry per MIME type, sorted by MIME type.
+	ByMimeType []MimeTypeStats `json:"by_mime_type"`
+}
+
+// BatchStatsCollector accumulates BatchStats as inputs finish. Its Record method
+// has the signature of a completion callback, so it plugs into the batch APIs:
+//
+//	stats := kreuzberg.NewBatchStatsCollector()
+//	results, err := kreuzberg.BatchExtractFilesSync(paths, kreuzberg.NewExtractionConfig(
+//		kreuzberg.WithOnComplete(stats.Record),
+//	))
+//	report := stats.Stats()
+//
+// Successful inputs are grouped by result.MimeType. Failed inputs are grouped by
+// the MIME type detected from their path, or under "unknown" when it cannot be
+// detected, as with byte batches, which pass the input index. A collector is safe
+// for concurrent use and can be shared across several batches to summarize a
+// whole ingestion run.
+type BatchStatsCollector struct {
+	mu     sync.Mutex
+	total  MimeTypeStats
+	byMime map[string]*MimeTypeStats
+}
+
+// NewBatchStatsCollector returns an empty collector.
+func NewBatchStatsCollector() *BatchStatsCollector {
+	return &BatchStatsCollector{byMime: map[string]*MimeTypeStats{}}
+}
+
+// Record adds the outcome of one input.
+func (c *BatchStatsCollector) Record(path string, result *ExtractionResult, err error) {
+	mimeType := unknownMimeType
+	switch {
+	case err == nil && result != nil && result.MimeType != "":
+		mimeType = result.MimeType
+	case path != "":
+		if detected, detectErr := DetectMimeTypeFromPath(path); detectErr == nil && detected != "" {
+			mimeType = detected
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats, ok := c.byMime[mimeType]
+	if !ok {
+		stats = &MimeTypeStats{MimeType: mimeType}
+		c.byMime[mimeType] = stats
+	}
+	stats.add(result, err)
+	c.total.add(result, err)
+}
+
+// Stats returns a snapshot of the statistics recorded so far.
+func (c *BatchStatsCollector) Stats() BatchStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	report := BatchStats{Total: c.total, ByMimeType: make([]MimeTypeStats, 0, len(c.byMime))}
+	for _, stats := range c.byMime {
+		report.ByMimeType = append(report.ByMimeType, *stats)
+	}
+	sort.Slice(report.ByMimeType, func(i, j int) bool {
+		return report.ByMimeType[i].MimeType < report.ByMimeType[j].MimeType
+	})
+	return report
+}
+
+// add records one outcome.
+func (s *MimeTypeStats) add(result *ExtractionResult, err error) {
+	s.Count++
+	if err != nil || result == nil {
+		s.Failed++
+		return
+	}
+	s.Succeeded++
+	if duration := resultDuration(result); duration > 0 {
+		s.TotalDuration += duration
+		s.TimedCount++
+	}
+	s.TotalPages += resultPageCount(result)
+}
+
+// resultDuration returns the extraction time of result from its native metrics,
+// falling back to the time measured by the Go binding.
+func resultDuration(result *ExtractionResult) time.Duration {
+	if result.Metrics != nil && result.Metrics.TotalDuration > 0 {
+		return result.Metrics.TotalDuration
+	}
+	return result.ElapsedTime
+}
+
+// shareElapsedTime sets the ElapsedTime of each result to an even share of the
+// elapsed time of the native call that produced them.
+func shareElapsedTime(results []*ExtractionResult, elapsed time.Duration) {
+	if len(results) == 0 {
+		return
+	}
+	share := elapsed / time.Duration(len(results))
+	for _, result := range results {
+		if result != nil {
+			result.ElapsedTime = share
+		}
+	}
+}
+
+// resultPageCount returns the page count of result from its metrics, its page
+// metadata, or its extracted pages, in that order of preference.
+func resultPageCount(result *ExtractionResult) uint64 {
+	if result.Metrics != nil && result.Metrics.PageCount > 0 {
+		return uint64(result.Metrics.PageCount)
+	}
+	if result.Metadata.Pages != nil && result.Metadata.Pages.TotalCount > 0 {
+		return result.Metadata.Pages.TotalCount
+	}
+	return uint64(len(result.Pages))
+}
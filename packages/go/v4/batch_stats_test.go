@@ -0,0 +1,60 @@
+package kreuzberg
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestBatchStatsCollector(t *testing.T) {
+	stats := NewBatchStatsCollector()
+	stats.Record("a.pdf", &ExtractionResult{
+		MimeType: "application/pdf",
+		Metrics:  &ExtractionMetrics{TotalDuration: 3 * time.Second, PageCount: 10},
+	}, nil)
+	stats.Record("b.pdf", &ExtractionResult{
+		MimeType: "application/pdf",
+		Metrics:  &ExtractionMetrics{TotalDuration: time.Second, PageCount: 2},
+	}, nil)
+	stats.Record("c.txt", &ExtractionResult{MimeType: "text/plain"}, nil)
+	stats.Record("", nil, errors.New("corrupt input"))
+	stats.Record("d.html", &ExtractionResult{MimeType: "text/html", ElapsedTime: 500 * time.Millisecond}, nil)
+
+	report := stats.Stats()
+	if report.Total.Count != 5 || report.Total.Succeeded != 4 || report.Total.Failed != 1 {
+		t.Errorf("unexpected totals: %+v", report.Total)
+	}
+	if len(report.ByMimeType) != 4 {
+		t.Fatalf("expected 4 MIME types, got %+v", report.ByMimeType)
+	}
+
+	pdf := report.ByMimeType[0]
+	if pdf.MimeType != "application/pdf" {
+		t.Fatalf("expected MIME types to be sorted, got %q first", pdf.MimeType)
+	}
+	if pdf.SuccessRate() != 1 || pdf.AverageDuration() != 2*time.Second || pdf.AveragePageCount() != 6 {
+		t.Errorf("unexpected PDF stats: rate %v, duration %v, pages %v", pdf.SuccessRate(), pdf.AverageDuration(), pdf.AveragePageCount())
+	}
+
+	html := report.ByMimeType[1]
+	if html.MimeType != "text/html" || html.AverageDuration() != 500*time.Millisecond {
+		t.Errorf("expected the measured elapsed time without native metrics, got %+v", html)
+	}
+	text := report.ByMimeType[2]
+	if text.MimeType != "text/plain" || text.AverageDuration() != 0 {
+		t.Errorf("expected untimed results not to affect the average, got %+v", text)
+	}
+	unknown := report.ByMimeType[3]
+	if unknown.MimeType != unknownMimeType || unknown.Failed != 1 || unknown.SuccessRate() != 0 {
+		t.Errorf("unexpected stats for failed input: %+v", unknown)
+	}
+}
+
+func TestShareElapsedTime(t *testing.T) {
+	results := []*ExtractionResult{{}, nil, {}, {}}
+	shareElapsedTime(results, 4*time.Second)
+	if results[0].ElapsedTime != time.Second || results[3].ElapsedTime != time.Second {
+		t.Errorf("expected an even share per input, got %v and %v", results[0].ElapsedTime, results[3].ElapsedTime)
+	}
+	shareElapsedTime(nil, time.Second)
+}
@@ -63,10 +63,14 @@ import "C"
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
+	"time"
 	"unsafe"
 )
 
@@ -83,22 +87,51 @@ type BytesWithMime struct {
 
 // ExtractFileSync extracts content and metadata from the file at the provided path.
 func ExtractFileSync(path string, config *ExtractionConfig) (*ExtractionResult, error) {
+	return extractFile(context.Background(), path, config)
+}
+
+// extractFile implements ExtractFileSync and ExtractFileWithContext. ctx is
+// checked before the extraction starts, once the FFI lock is held, and after the
+// native call returns; once it is done, ctx.Err() is returned and no Go-side
+// hooks run.
+func extractFile(ctx context.Context, path string, config *ExtractionConfig) (*ExtractionResult, error) {
 	// Validate path is not empty
 	if path == "" {
 		return nil, newValidationErrorWithContext("path is required", nil, ErrorCodeValidation, nil)
 	}
-
-	// Validate chunking parameters if provided in config
-	if config != nil && config.Chunking != nil {
-		if err := validateChunkingConfig(config.Chunking); err != nil {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if config != nil && config.ContentCallback != nil {
+		err := streamContent(ctx, config, func(cfg *ExtractionConfig) (*ExtractionResult, error) {
+			return extractFile(ctx, path, cfg)
+		})
+		if err != nil {
 			return nil, err
 		}
 	}
+	config = progressContextConfig(ctx, config)
+
+	release, err := beginExtraction()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := validateConfigBeforeFFI(config); err != nil {
+		return nil, err
+	}
+
+	config, tempCleanup, err := prepareTempDir(config)
+	if err != nil {
+		return nil, err
+	}
+	defer tempCleanup()
 
 	cPath := C.CString(path)
 	defer C.free(unsafe.Pointer(cPath))
 
-	cfgPtr, cfgCleanup, err := newConfigJSON(config)
+	cfgPtr, cfgCleanup, err := newConfigJSON(cacheConfig(config))
 	if err != nil {
 		return nil, err
 	}
@@ -106,37 +139,93 @@ func ExtractFileSync(path string, config *ExtractionConfig) (*ExtractionResult,
 		defer cfgCleanup()
 	}
 
+	tracker := startResourceTracking(config)
+	reportProgress(config, ProgressEvent{Stage: ProgressStageExtracting})
+	result, err := extractFileNative(ctx, cPath, cfgPtr, tracker)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	tracker.finish(result)
+	return finalizeWithProgress(result, config)
+}
+
+// extractFileNative performs the serialized FFI call for ExtractFileSync.
+func extractFileNative(ctx context.Context, cPath *C.char, cfgPtr *C.char, tracker *resourceTracker) (*ExtractionResult, error) {
 	// Serialize FFI calls to prevent concurrent PDFium access
 	ffiMutex.Lock()
 	defer ffiMutex.Unlock()
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	tracker.begin()
+	defer tracker.end()
+
+	start := time.Now()
 	var cRes *C.CExtractionResult
 	if cfgPtr != nil {
 		cRes = C.kreuzberg_extract_file_sync_with_config(cPath, cfgPtr)
 	} else {
 		cRes = C.kreuzberg_extract_file_sync(cPath)
 	}
+	elapsed := time.Since(start)
 
 	if cRes == nil {
 		return nil, lastError()
 	}
 	defer C.kreuzberg_free_result(cRes)
 
-	return convertCResult(cRes)
+	result, err := convertCResult(cRes)
+	if err != nil {
+		return nil, err
+	}
+	shareElapsedTime([]*ExtractionResult{result}, elapsed)
+	return result, nil
 }
 
 // ExtractBytesSync extracts content and metadata from a byte array with the given MIME type.
 func ExtractBytesSync(data []byte, mimeType string, config *ExtractionConfig) (*ExtractionResult, error) {
+	return extractBytes(context.Background(), data, mimeType, config)
+}
+
+// extractBytes implements ExtractBytesSync and ExtractBytesWithContext; see
+// extractFile for how ctx is checked.
+func extractBytes(ctx context.Context, data []byte, mimeType string, config *ExtractionConfig) (*ExtractionResult, error) {
 	if mimeType == "" {
 		return nil, newValidationErrorWithContext("mimeType is required", nil, ErrorCodeValidation, nil)
 	}
-
-	// Validate chunking parameters if provided in config
-	if config != nil && config.Chunking != nil {
-		if err := validateChunkingConfig(config.Chunking); err != nil {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if config != nil && config.ContentCallback != nil {
+		err := streamContent(ctx, config, func(cfg *ExtractionConfig) (*ExtractionResult, error) {
+			return extractBytes(ctx, data, mimeType, cfg)
+		})
+		if err != nil {
 			return nil, err
 		}
 	}
+	config = progressContextConfig(ctx, config)
+
+	release, err := beginExtraction()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := validateConfigBeforeFFI(config); err != nil {
+		return nil, err
+	}
+
+	config, tempCleanup, err := prepareTempDir(config)
+	if err != nil {
+		return nil, err
+	}
+	defer tempCleanup()
 
 	buf := C.CBytes(data)
 	defer C.free(buf)
@@ -144,7 +233,7 @@ func ExtractBytesSync(data []byte, mimeType string, config *ExtractionConfig) (*
 	cMime := C.CString(mimeType)
 	defer C.free(unsafe.Pointer(cMime))
 
-	cfgPtr, cfgCleanup, err := newConfigJSON(config)
+	cfgPtr, cfgCleanup, err := newConfigJSON(cacheConfig(config))
 	if err != nil {
 		return nil, err
 	}
@@ -152,37 +241,101 @@ func ExtractBytesSync(data []byte, mimeType string, config *ExtractionConfig) (*
 		defer cfgCleanup()
 	}
 
+	tracker := startResourceTracking(config)
+	reportProgress(config, ProgressEvent{Stage: ProgressStageExtracting})
+	result, err := extractBytesNative(ctx, buf, len(data), cMime, cfgPtr, tracker)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	tracker.finish(result)
+	return finalizeWithProgress(result, config)
+}
+
+// extractBytesNative performs the serialized FFI call for ExtractBytesSync.
+func extractBytesNative(ctx context.Context, buf unsafe.Pointer, length int, cMime *C.char, cfgPtr *C.char, tracker *resourceTracker) (*ExtractionResult, error) {
 	// Serialize FFI calls to prevent concurrent PDFium access
 	ffiMutex.Lock()
 	defer ffiMutex.Unlock()
 
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	tracker.begin()
+	defer tracker.end()
+
+	start := time.Now()
 	var cRes *C.CExtractionResult
 	if cfgPtr != nil {
-		cRes = C.kreuzberg_extract_bytes_sync_with_config((*C.uint8_t)(buf), C.uintptr_t(len(data)), cMime, cfgPtr)
+		cRes = C.kreuzberg_extract_bytes_sync_with_config((*C.uint8_t)(buf), C.uintptr_t(length), cMime, cfgPtr)
 	} else {
-		cRes = C.kreuzberg_extract_bytes_sync((*C.uint8_t)(buf), C.uintptr_t(len(data)), cMime)
+		cRes = C.kreuzberg_extract_bytes_sync((*C.uint8_t)(buf), C.uintptr_t(length), cMime)
 	}
+	elapsed := time.Since(start)
 
 	if cRes == nil {
 		return nil, lastError()
 	}
 	defer C.kreuzberg_free_result(cRes)
 
-	return convertCResult(cRes)
+	result, err := convertCResult(cRes)
+	if err != nil {
+		return nil, err
+	}
+	shareElapsedTime([]*ExtractionResult{result}, elapsed)
+	return result, nil
 }
 
 // BatchExtractFilesSync extracts multiple files sequentially but leverages the optimized batch pipeline.
+// Any failure before or during the native batch call fails the whole batch; use
+// BatchExtractFilesItems to get a result or an error for each input.
 func BatchExtractFilesSync(paths []string, config *ExtractionConfig) ([]*ExtractionResult, error) {
+	return batchExtractFiles(context.Background(), paths, config)
+}
+
+// batchExtractFiles implements BatchExtractFilesSync and
+// BatchExtractFilesWithContext; see extractFile for how ctx is checked.
+func batchExtractFiles(ctx context.Context, paths []string, config *ExtractionConfig) ([]*ExtractionResult, error) {
 	if len(paths) == 0 {
 		return []*ExtractionResult{}, nil
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	notify := newBatchNotifier(config, func(i int) string { return paths[i] })
 
-	// Validate chunking parameters if provided in config
-	if config != nil && config.Chunking != nil {
-		if err := validateChunkingConfig(config.Chunking); err != nil {
-			return nil, err
-		}
+	results, err := batchExtractFilesRaw(ctx, paths, config)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		notify.failFrom(0, len(paths), err)
+		return nil, err
 	}
+	return finalizeBatchResults(results, len(paths), config, notify)
+}
+
+// batchExtractFilesRaw runs the native batch extraction of paths and returns the
+// results before Go-side options are applied.
+func batchExtractFilesRaw(ctx context.Context, paths []string, config *ExtractionConfig) ([]*ExtractionResult, error) {
+	release, err := beginExtraction()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := validateConfigBeforeFFI(config); err != nil {
+		return nil, err
+	}
+
+	config, tempCleanup, err := prepareTempDir(config)
+	if err != nil {
+		return nil, err
+	}
+	defer tempCleanup()
 
 	cStrings := make([]*C.char, len(paths))
 	for i, path := range paths {
@@ -197,7 +350,7 @@ func BatchExtractFilesSync(paths []string, config *ExtractionConfig) ([]*Extract
 		}
 	}()
 
-	cfgPtr, cfgCleanup, err := newConfigJSON(config)
+	cfgPtr, cfgCleanup, err := newConfigJSON(cacheConfig(config))
 	if err != nil {
 		return nil, err
 	}
@@ -205,31 +358,81 @@ func BatchExtractFilesSync(paths []string, config *ExtractionConfig) ([]*Extract
 		defer cfgCleanup()
 	}
 
+	return batchExtractFilesNative(ctx, cStrings, cfgPtr)
+}
+
+// batchExtractFilesNative performs the serialized FFI call for BatchExtractFilesSync.
+func batchExtractFilesNative(ctx context.Context, cStrings []*C.char, cfgPtr *C.char) ([]*ExtractionResult, error) {
 	// Serialize FFI calls to prevent concurrent PDFium access
 	ffiMutex.Lock()
 	defer ffiMutex.Unlock()
 
-	batch := C.kreuzberg_batch_extract_files_sync((**C.char)(unsafe.Pointer(&cStrings[0])), C.uintptr_t(len(paths)), cfgPtr)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	batch := C.kreuzberg_batch_extract_files_sync((**C.char)(unsafe.Pointer(&cStrings[0])), C.uintptr_t(len(cStrings)), cfgPtr)
+	elapsed := time.Since(start)
 	if batch == nil {
 		return nil, lastError()
 	}
 	defer C.kreuzberg_free_batch_result(batch)
 
-	return convertCBatchResult(batch)
+	results, err := convertCBatchResult(batch)
+	if err != nil {
+		return nil, err
+	}
+	shareElapsedTime(results, elapsed)
+	return results, nil
 }
 
 // BatchExtractBytesSync processes multiple in-memory documents in one pass.
+// Use BatchExtractBytesItems to get a result or an error for each input.
 func BatchExtractBytesSync(items []BytesWithMime, config *ExtractionConfig) ([]*ExtractionResult, error) {
+	return batchExtractBytes(context.Background(), items, config)
+}
+
+// batchExtractBytes implements BatchExtractBytesSync and
+// BatchExtractBytesWithContext; see extractFile for how ctx is checked.
+func batchExtractBytes(ctx context.Context, items []BytesWithMime, config *ExtractionConfig) ([]*ExtractionResult, error) {
 	if len(items) == 0 {
 		return []*ExtractionResult{}, nil
 	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	notify := newBatchNotifier(config, strconv.Itoa)
 
-	// Validate chunking parameters if provided in config
-	if config != nil && config.Chunking != nil {
-		if err := validateChunkingConfig(config.Chunking); err != nil {
-			return nil, err
-		}
+	results, err := batchExtractBytesRaw(ctx, items, config)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		notify.failFrom(0, len(items), err)
+		return nil, err
+	}
+	return finalizeBatchResults(results, len(items), config, notify)
+}
+
+// batchExtractBytesRaw runs the native batch extraction of items and returns the
+// results before Go-side options are applied.
+func batchExtractBytesRaw(ctx context.Context, items []BytesWithMime, config *ExtractionConfig) ([]*ExtractionResult, error) {
+	release, err := beginExtraction()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if err := validateConfigBeforeFFI(config); err != nil {
+		return nil, err
+	}
+
+	config, tempCleanup, err := prepareTempDir(config)
+	if err != nil {
+		return nil, err
 	}
+	defer tempCleanup()
 
 	cItems := make([]C.CBytesWithMime, len(items))
 	cBuffers := make([]unsafe.Pointer, len(items))
@@ -262,7 +465,7 @@ func BatchExtractBytesSync(items []BytesWithMime, config *ExtractionConfig) ([]*
 		}
 	}()
 
-	cfgPtr, cfgCleanup, err := newConfigJSON(config)
+	cfgPtr, cfgCleanup, err := newConfigJSON(cacheConfig(config))
 	if err != nil {
 		return nil, err
 	}
@@ -270,57 +473,76 @@ func BatchExtractBytesSync(items []BytesWithMime, config *ExtractionConfig) ([]*
 		defer cfgCleanup()
 	}
 
+	return batchExtractBytesNative(ctx, cItems, cfgPtr)
+}
+
+// batchExtractBytesNative performs the serialized FFI call for BatchExtractBytesSync.
+func batchExtractBytesNative(ctx context.Context, cItems []C.CBytesWithMime, cfgPtr *C.char) ([]*ExtractionResult, error) {
 	// Serialize FFI calls to prevent concurrent PDFium access
 	ffiMutex.Lock()
 	defer ffiMutex.Unlock()
 
-	batch := C.kreuzberg_batch_extract_bytes_sync((*C.CBytesWithMime)(unsafe.Pointer(&cItems[0])), C.uintptr_t(len(items)), cfgPtr)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	batch := C.kreuzberg_batch_extract_bytes_sync((*C.CBytesWithMime)(unsafe.Pointer(&cItems[0])), C.uintptr_t(len(cItems)), cfgPtr)
+	elapsed := time.Since(start)
 	if batch == nil {
 		return nil, lastError()
 	}
 	defer C.kreuzberg_free_batch_result(batch)
 
-	return convertCBatchResult(batch)
+	results, err := convertCBatchResult(batch)
+	if err != nil {
+		return nil, err
+	}
+	shareElapsedTime(results, elapsed)
+	return results, nil
 }
 
-// ExtractFileWithContext extracts content and metadata from a file at the given path,
-// respecting the provided context for cancellation. Note that extraction operations
-// cannot be interrupted mid-way; this cancellation check occurs before starting extraction.
+// ExtractFileWithContext extracts content and metadata from a file at the given
+// path, stopping early when ctx is done. The native core cannot interrupt a
+// running extraction, so the call runs synchronously and checks ctx before it
+// starts, once it holds the FFI lock, and after the native call returns. When ctx
+// is done it returns ctx.Err() at the latest once the native call in flight has
+// finished, either its own or the one it waits on for the FFI lock, so the
+// worst-case latency is the duration of one native extraction, which can be
+// minutes for a large scanned PDF. Nothing keeps running after it returns, and
+// once ctx is done no Go-side hooks, such as ContentCallback, ContentValidator, or
+// ProgressCallback, are called.
 func ExtractFileWithContext(ctx context.Context, path string, config *ExtractionConfig) (*ExtractionResult, error) {
-	if err := ctx.Err(); err != nil {
-		return nil, err
-	}
-	return ExtractFileSync(path, config)
+	return extractFile(ctx, path, config)
+}
+
+// ExtractFirstPage extracts only the first page of the file at path; see
+// WithFirstPageOnly. The caller's config is not modified.
+func ExtractFirstPage(path string, config *ExtractionConfig) (*ExtractionResult, error) {
+	cfg := cloneConfig(config)
+	WithFirstPageOnly(true)(cfg)
+	return ExtractFileSync(path, cfg)
 }
 
 // ExtractBytesWithContext extracts content and metadata from a byte array,
-// respecting the provided context for cancellation. Note that extraction operations
-// cannot be interrupted mid-way; this cancellation check occurs before starting extraction.
+// stopping early when ctx is done; see ExtractFileWithContext for when ctx is
+// checked and the worst-case latency.
 func ExtractBytesWithContext(ctx context.Context, data []byte, mimeType string, config *ExtractionConfig) (*ExtractionResult, error) {
-	if err := ctx.Err(); err != nil {
-		return nil, err
-	}
-	return ExtractBytesSync(data, mimeType, config)
+	return extractBytes(ctx, data, mimeType, config)
 }
 
-// BatchExtractFilesWithContext extracts multiple files respecting the provided context
-// for cancellation. Note that extraction operations cannot be interrupted mid-way;
-// this cancellation check occurs before starting the batch operation.
+// BatchExtractFilesWithContext extracts multiple files, stopping early when ctx
+// is done; see ExtractFileWithContext. The whole batch is one native call, so the
+// worst-case latency is the duration of the entire batch. Once ctx is done,
+// OnComplete is not called.
 func BatchExtractFilesWithContext(ctx context.Context, paths []string, config *ExtractionConfig) ([]*ExtractionResult, error) {
-	if err := ctx.Err(); err != nil {
-		return nil, err
-	}
-	return BatchExtractFilesSync(paths, config)
+	return batchExtractFiles(ctx, paths, config)
 }
 
-// BatchExtractBytesWithContext processes multiple in-memory documents respecting the
-// provided context for cancellation. Note that extraction operations cannot be
-// interrupted mid-way; this cancellation check occurs before starting the batch operation.
+// BatchExtractBytesWithContext processes multiple in-memory documents, stopping
+// early when ctx is done; see BatchExtractFilesWithContext.
 func BatchExtractBytesWithContext(ctx context.Context, items []BytesWithMime, config *ExtractionConfig) ([]*ExtractionResult, error) {
-	if err := ctx.Err(); err != nil {
-		return nil, err
-	}
-	return BatchExtractBytesSync(items, config)
+	return batchExtractBytes(ctx, items, config)
 }
 
 // LibraryVersion returns the underlying Rust crate version string.
@@ -407,6 +629,10 @@ func convertCResult(cRes *C.CExtractionResult) (*ExtractionResult, error) {
 		return nil, newSerializationErrorWithContext("failed to decode elements", err, ErrorCodeValidation, nil)
 	}
 
+	if err := result.promoteMetadataFields(); err != nil {
+		return nil, err
+	}
+
 	return result, nil
 }
 
@@ -556,6 +782,11 @@ func ConfigDiscover() (*ExtractionConfig, error) {
 	return nil, nil
 }
 
+// mimeSniffLength is the number of leading bytes read for content-based MIME
+// detection. It is large enough to reach the package part names that identify
+// DOCX, XLSX, and PPTX archives.
+const mimeSniffLength = 64 * 1024
+
 // DetectMimeType detects MIME type from byte content using magic bytes.
 func DetectMimeType(data []byte) (string, error) {
 	if len(data) == 0 {
@@ -598,6 +829,36 @@ func DetectMimeTypeFromPath(path string) (string, error) {
 	return C.GoString(ptr), nil
 }
 
+// DetectMimeTypeFile detects the MIME type of the file at path from its content,
+// ignoring the file extension. It uses the same detection as extraction, so a
+// PDF saved as "report.bin" is still reported as "application/pdf". Recognized
+// types include PDF, common image formats, DOCX/XLSX/PPTX, HTML, and plain text;
+// the result is a canonical MIME type without parameters.
+//
+// Only the first bytes of the file are read. Use DetectMimeTypeFromPath to take
+// the extension into account as well.
+func DetectMimeTypeFile(path string) (string, error) {
+	if path == "" {
+		return "", newValidationErrorWithContext("path cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return "", newIOErrorWithContext(fmt.Sprintf("failed to open %s", path), err, ErrorCodeIo, nil)
+	}
+	defer file.Close()
+
+	head := make([]byte, mimeSniffLength)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", newIOErrorWithContext(fmt.Sprintf("failed to read %s", path), err, ErrorCodeIo, nil)
+	}
+	if n == 0 {
+		return "", newValidationErrorWithContext(fmt.Sprintf("file is empty: %s", path), nil, ErrorCodeValidation, nil)
+	}
+	return DetectMimeType(head[:n])
+}
+
 // GetExtensionsForMime returns file extensions associated with a MIME type.
 func GetExtensionsForMime(mimeType string) ([]string, error) {
 	if mimeType == "" {
@@ -702,6 +963,84 @@ func GetEmbeddingPreset(name string) (*EmbeddingPreset, error) {
 	return &preset, nil
 }
 
+// validateConfigBeforeFFI runs the Go-side config checks that must pass before
+// a config is serialized across the FFI boundary.
+func validateConfigBeforeFFI(config *ExtractionConfig) error {
+	if config == nil {
+		return nil
+	}
+	if config.Chunking != nil {
+		if err := validateChunkingConfig(config.Chunking); err != nil {
+			return err
+		}
+	}
+	if config.Spreadsheet != nil && config.Spreadsheet.FormulaMode != "" {
+		if err := ValidateSpreadsheetFormulaMode(config.Spreadsheet.FormulaMode); err != nil {
+			return err
+		}
+	}
+	if config.PdfOptions != nil && config.PdfOptions.ColumnGapThreshold != nil {
+		if err := ValidateColumnGapThreshold(*config.PdfOptions.ColumnGapThreshold); err != nil {
+			return err
+		}
+	}
+	if config.InlineLinkStyle != "" {
+		if err := ValidateInlineLinkStyle(config.InlineLinkStyle); err != nil {
+			return err
+		}
+	}
+	if config.HyphenationLanguage != "" {
+		if err := ValidateHyphenationLanguage(config.HyphenationLanguage); err != nil {
+			return err
+		}
+	}
+	if config.RubyHandling != "" {
+		if err := ValidateRubyHandling(config.RubyHandling); err != nil {
+			return err
+		}
+	}
+	if config.NormalizeNumbers != "" {
+		if err := ValidateNumberLocale(config.NormalizeNumbers); err != nil {
+			return err
+		}
+	}
+	if config.CacheKeyStrategy != "" {
+		if err := ValidateCacheKeyStrategy(config.CacheKeyStrategy); err != nil {
+			return err
+		}
+	}
+	if config.MaxTablesPerPage != nil && *config.MaxTablesPerPage <= 0 {
+		return newValidationErrorWithContext(fmt.Sprintf("invalid max tables per page: %d (must be > 0)", *config.MaxTablesPerPage), nil, ErrorCodeValidation, nil)
+	}
+	if config.MaxContentBytes != nil && *config.MaxContentBytes <= 0 {
+		return newValidationErrorWithContext(fmt.Sprintf("invalid max content bytes: %d (must be > 0)", *config.MaxContentBytes), nil, ErrorCodeValidation, nil)
+	}
+	if config.OCR != nil && config.OCR.Backend != "" {
+		if err := validateOCRBackendName(config.OCR.Backend); err != nil {
+			return err
+		}
+	}
+	if config.OCR != nil {
+		for _, backend := range config.OCR.AdditionalBackends {
+			if err := validateOCRBackendName(backend); err != nil {
+				return err
+			}
+		}
+	}
+	if config.OCR != nil && config.OCR.Tesseract != nil {
+		tesseract := config.OCR.Tesseract
+		if conflict := charListConflict(tesseract.TesseditCharWhitelist, tesseract.TesseditCharBlacklist); conflict != "" {
+			return newValidationErrorWithContext(fmt.Sprintf("characters %q are both whitelisted and blacklisted", conflict), nil, ErrorCodeValidation, nil)
+		}
+	}
+	if config.OCR != nil && config.OCR.MergeStrategy != "" {
+		if err := ValidateOCRMergeStrategy(config.OCR.MergeStrategy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // validateChunkingConfig validates chunking configuration parameters.
 // It checks that ChunkSize and ChunkOverlap are positive when set, and that overlap < chunk size.
 // These validations are performed before FFI calls.
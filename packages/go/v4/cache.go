@@ -0,0 +1,264 @@
+package kreuzberg
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+)
+
+// Cache key strategies accepted by ExtractionConfig.CacheKeyStrategy.
+const (
+	// CacheKeyStrategyContentHash keys cache entries on a hash of the input bytes.
+	CacheKeyStrategyContentHash = "content-hash"
+	// CacheKeyStrategyPathMtime keys cache entries on the input path and its
+	// modification time.
+	CacheKeyStrategyPathMtime = "path+mtime"
+)
+
+// DefaultCacheDir returns the directory that holds the extraction cache when
+// caching is enabled with WithUseCache(true) and WithCacheDirectory is not used:
+// a "kreuzberg" directory inside the user's cache directory (os.UserCacheDir), or
+// inside os.TempDir when there is none. Without either option the native core
+// keeps its cache wherever it chooses.
+func DefaultCacheDir() string {
+	if dir, err := os.UserCacheDir(); err == nil {
+		return filepath.Join(dir, "kreuzberg")
+	}
+	return filepath.Join(os.TempDir(), "kreuzberg-cache")
+}
+
+// cacheDirectory returns the cache directory configured in config, or
+// DefaultCacheDir when none is.
+func cacheDirectory(config *ExtractionConfig) string {
+	if config != nil && config.CacheDir != nil && *config.CacheDir != "" {
+		return *config.CacheDir
+	}
+	return DefaultCacheDir()
+}
+
+// cacheEnabled reports whether config leaves the extraction cache enabled.
+func cacheEnabled(config *ExtractionConfig) bool {
+	return config == nil || config.UseCache == nil || *config.UseCache
+}
+
+// cacheKeyed reports whether the cache location of config is chosen on the Go
+// side: when the caller enabled caching explicitly or set a cache directory.
+// Otherwise the native core keeps its own cache location.
+func cacheKeyed(config *ExtractionConfig) bool {
+	if config == nil || !cacheEnabled(config) {
+		return false
+	}
+	return config.UseCache != nil || (config.CacheDir != nil && *config.CacheDir != "")
+}
+
+// withCacheKey returns a copy of config, the config sent to the native core,
+// whose CacheDir is the subdirectory of the cache directory named after
+// config's Fingerprint. Results cached under one config are thus never served
+// to an extraction with another. Configs for which cacheKeyed is false are
+// returned unchanged.
+func withCacheKey(config *ExtractionConfig) *ExtractionConfig {
+	if !cacheKeyed(config) {
+		return config
+	}
+	cfg := *config
+	dir := filepath.Join(cacheDirectory(config), "config-"+config.Fingerprint()[:16])
+	cfg.CacheDir = &dir
+	return &cfg
+}
+
+// cacheDirTagName is the name of the tag file that marks a kreuzberg cache
+// directory, following the Cache Directory Tagging Specification.
+const cacheDirTagName = "CACHEDIR.TAG"
+
+// cacheDirTag is the content of the tag file. The signature line is required by
+// the specification; the comment identifies kreuzberg as the owner.
+const cacheDirTag = "Signature: 8a477f597d28d172789f06886806bc55\n" +
+	"# This file is a cache directory tag created by kreuzberg.\n"
+
+// taggedCacheDirs holds the cache directories tagged by this process.
+var taggedCacheDirs sync.Map
+
+// cacheConfig returns the config sent to the native core for config: the
+// nativeConfig copy with the cache key of withCacheKey.
+func cacheConfig(config *ExtractionConfig) *ExtractionConfig {
+	return withCacheKey(nativeConfig(config))
+}
+
+// tagCacheDir writes the tag file into dir, once the native core has created it,
+// unless this process already did. Failures are ignored: an untagged directory is
+// only refused by ClearCache.
+func tagCacheDir(dir string) {
+	if _, done := taggedCacheDirs.Load(dir); done {
+		return
+	}
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return
+	}
+	tag := filepath.Join(dir, cacheDirTagName)
+	if isKreuzbergCacheDir(dir) {
+		taggedCacheDirs.Store(dir, struct{}{})
+		return
+	}
+	if err := os.WriteFile(tag, []byte(cacheDirTag), 0o644); err == nil {
+		taggedCacheDirs.Store(dir, struct{}{})
+	}
+}
+
+// isKreuzbergCacheDir reports whether dir holds the tag file written by
+// tagCacheDir.
+func isKreuzbergCacheDir(dir string) bool {
+	data, err := os.ReadFile(filepath.Join(dir, cacheDirTagName))
+	return err == nil && string(data) == cacheDirTag
+}
+
+// ClearCache removes the entries of the extraction cache directories chosen on
+// the Go side: DefaultCacheDir and every directory configured with
+// WithCacheDirectory by an extraction of this process. Use ClearCacheDir for a
+// cache directory this process has not used. Stale entries are then not served
+// after the native core or the extraction settings change.
+//
+// The native core exposes no call for clearing its cache, so entries it wrote to
+// a location of its own choosing, used when neither WithUseCache(true) nor
+// WithCacheDirectory is set, are not removed. Set one of them to keep the whole
+// cache clearable.
+//
+// A directory is only cleared when it holds the CACHEDIR.TAG file that kreuzberg
+// writes into every cache directory it uses; ClearCache fails for any other
+// directory without removing anything from it. See ClearCacheDir for details.
+func ClearCache() error {
+	dirs := []string{DefaultCacheDir()}
+	cacheDirs.Range(func(key, _ any) bool {
+		if dir := key.(string); dir != dirs[0] {
+			dirs = append(dirs, dir)
+		}
+		return true
+	})
+
+	ffiMutex.Lock()
+	defer ffiMutex.Unlock()
+	for _, dir := range dirs {
+		if err := clearCacheDir(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClearCacheDir removes every entry of the extraction cache stored in dir, a
+// directory configured with WithCacheDirectory. The directory itself and its
+// CACHEDIR.TAG file are kept. A directory that does not exist is treated as an
+// empty cache, and a directory without the tag file kreuzberg writes into its
+// cache directories is refused with a validation error, so a mistyped path
+// cannot wipe unrelated files.
+//
+// The native core exposes no call for clearing its cache, so the files are
+// removed directly; ClearCacheDir waits for in-flight extractions of this
+// process to finish and blocks new ones while it runs. Other processes sharing
+// dir are not coordinated with and should be stopped first.
+func ClearCacheDir(dir string) error {
+	if dir == "" {
+		return newValidationErrorWithContext("cache directory cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+
+	ffiMutex.Lock()
+	defer ffiMutex.Unlock()
+	return clearCacheDir(dir)
+}
+
+// clearCacheDir implements ClearCacheDir. The caller holds ffiMutex.
+func clearCacheDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return newIOErrorWithContext("failed to read cache directory", err, ErrorCodeIo, nil)
+	}
+	if !isKreuzbergCacheDir(dir) {
+		return newValidationErrorWithContext("not a kreuzberg cache directory (no "+cacheDirTagName+"): "+dir, nil, ErrorCodeValidation, nil)
+	}
+	for _, entry := range entries {
+		if entry.Name() == cacheDirTagName {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return newIOErrorWithContext("failed to remove cache entry", err, ErrorCodeIo, nil)
+		}
+	}
+	return nil
+}
+
+// Process-wide cache counters reported by CacheStats.
+var (
+	cacheHits   atomic.Int64
+	cacheMisses atomic.Int64
+	// cacheDirs holds every cache directory used by an extraction of this process.
+	cacheDirs sync.Map
+)
+
+// CacheStats reports how the extraction cache performed in this process: hits
+// and misses count the results the native core did and did not serve from its
+// cache, as reported by ExtractionResult.CacheHit, across all extractions with
+// caching enabled. entries is the number of files in the cache directories
+// chosen on the Go side for those extractions: the one configured with
+// WithCacheDirectory, or DefaultCacheDir with WithUseCache(true). Entries in a
+// location chosen by the native core are not counted.
+//
+// The counters are process-global and safe to read while extractions run. The
+// error reports a cache directory that could not be read.
+func CacheStats() (hits, misses, entries int64, err error) {
+	hits, misses = cacheHits.Load(), cacheMisses.Load()
+	cacheDirs.Range(func(key, _ any) bool {
+		var n int64
+		n, err = countCacheEntries(key.(string))
+		entries += n
+		return err == nil
+	})
+	if err != nil {
+		return hits, misses, entries, newIOErrorWithContext("failed to read cache directory", err, ErrorCodeIo, nil)
+	}
+	return hits, misses, entries, nil
+}
+
+// recordCacheLookup counts result in the CacheStats counters unless config
+// disables caching. When the cache location is chosen on the Go side, it also
+// records and tags the cache directory, so that CacheStats counts its entries and
+// ClearCache recognizes it.
+func recordCacheLookup(result *ExtractionResult, config *ExtractionConfig) {
+	if !cacheEnabled(config) {
+		return
+	}
+	if result.CacheHit {
+		cacheHits.Add(1)
+	} else {
+		cacheMisses.Add(1)
+	}
+	if cacheKeyed(config) {
+		dir := cacheDirectory(config)
+		cacheDirs.Store(dir, struct{}{})
+		tagCacheDir(dir)
+	}
+}
+
+// countCacheEntries returns the number of regular files under dir, not counting
+// its tag file. A directory that does not exist holds no entries.
+func countCacheEntries(dir string) (int64, error) {
+	var n int64
+	tag := filepath.Join(dir, cacheDirTagName)
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.Type().IsRegular() && path != tag {
+			n++
+		}
+		return nil
+	})
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	return n, err
+}
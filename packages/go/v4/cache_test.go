@@ -0,0 +1,182 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCacheOptions(t *testing.T) {
+	config := NewExtractionConfig(
+		WithCacheDirectory("/var/cache/kreuzberg"),
+		WithCacheKeyStrategy(CacheKeyStrategyPathMtime),
+	)
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	for _, want := range []string{`"cache_dir":"/var/cache/kreuzberg"`, `"cache_key_strategy":"path+mtime"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("expected %s in %s", want, data)
+		}
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("expected valid config, got %v", err)
+	}
+
+	invalid := NewExtractionConfig(WithCacheKeyStrategy("mtime"))
+	if err := invalid.Validate(); err == nil || !strings.Contains(err.Error(), "CacheKeyStrategy") {
+		t.Errorf("expected CacheKeyStrategy error, got %v", err)
+	}
+}
+
+func TestClearCacheDir(t *testing.T) {
+	dir := t.TempDir()
+	tagCacheDir(dir)
+	if err := os.WriteFile(filepath.Join(dir, "entry"), []byte("cached"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "shard", "nested"), 0o700); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ClearCacheDir(dir); err != nil {
+		t.Fatalf("ClearCacheDir: %v", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("cache directory should be kept: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Name() != cacheDirTagName {
+		t.Errorf("expected only the tag file to be kept, got %v", entries)
+	}
+
+	if err := ClearCacheDir(filepath.Join(dir, "missing")); err != nil {
+		t.Errorf("expected missing directory to be treated as empty, got %v", err)
+	}
+	if err := ClearCacheDir(""); err == nil {
+		t.Error("expected error for empty directory")
+	}
+}
+
+func TestClearCacheDirRefusesUntaggedDirectory(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "notes.txt")
+	if err := os.WriteFile(file, []byte("keep me"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ClearCacheDir(dir); err == nil {
+		t.Fatal("expected a directory without a kreuzberg tag to be refused")
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Errorf("expected the file to be kept, got %v", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, cacheDirTagName), []byte("Signature: 8a477f597d28d172789f06886806bc55\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ClearCacheDir(dir); err == nil {
+		t.Error("expected a cache directory tagged by another program to be refused")
+	}
+}
+
+func TestClearCache(t *testing.T) {
+	// Keep DefaultCacheDir away from the real user cache.
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CACHE_HOME", home)
+	t.Setenv("LocalAppData", home)
+
+	dir := t.TempDir()
+	config := NewExtractionConfig(WithCacheDirectory(dir))
+	if _, err := finalizeResult(&ExtractionResult{}, config); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "entry"), []byte("cached"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := ClearCache(); err != nil {
+		t.Fatalf("ClearCache: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "entry")); !os.IsNotExist(err) {
+		t.Errorf("expected the cache directory used by this process to be cleared, got %v", err)
+	}
+}
+
+func TestCacheStats(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "entry"), []byte("cached"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	hits, misses, _, err := CacheStats()
+	if err != nil {
+		t.Fatalf("CacheStats: %v", err)
+	}
+
+	config := NewExtractionConfig(WithCacheDirectory(dir))
+	if _, err := finalizeResult(&ExtractionResult{CacheHit: true}, config); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := finalizeResult(&ExtractionResult{}, config); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := finalizeResult(&ExtractionResult{CacheHit: true}, NewExtractionConfig(WithUseCache(false))); err != nil {
+		t.Fatal(err)
+	}
+
+	gotHits, gotMisses, entries, err := CacheStats()
+	if err != nil {
+		t.Fatalf("CacheStats: %v", err)
+	}
+	if gotHits-hits != 1 || gotMisses-misses != 1 {
+		t.Errorf("expected one hit and one miss, got %d hits and %d misses", gotHits-hits, gotMisses-misses)
+	}
+	if entries < 1 {
+		t.Errorf("expected the cache directory entry to be counted, got %d", entries)
+	}
+}
+
+func TestWithCacheKey(t *testing.T) {
+	dir := t.TempDir()
+	base := NewExtractionConfig(WithCacheDirectory(dir))
+	keyed := withCacheKey(base)
+	if filepath.Dir(*keyed.CacheDir) != dir {
+		t.Fatalf("expected a subdirectory of %s, got %s", dir, *keyed.CacheDir)
+	}
+	if *base.CacheDir != dir {
+		t.Error("caller config must not be mutated")
+	}
+	if *withCacheKey(base).CacheDir != *keyed.CacheDir {
+		t.Error("expected the same config to map to the same cache key")
+	}
+
+	changed := NewExtractionConfig(WithCacheDirectory(dir), WithForceOCR(true))
+	if *withCacheKey(changed).CacheDir == *keyed.CacheDir {
+		t.Error("expected a changed config to map to a different cache key")
+	}
+
+	if got := withCacheKey(NewExtractionConfig(WithUseCache(true))); got.CacheDir == nil || filepath.Dir(*got.CacheDir) != DefaultCacheDir() {
+		t.Errorf("expected explicitly enabled caching to use the default cache directory, got %+v", got)
+	}
+
+	for _, config := range []*ExtractionConfig{nil, NewExtractionConfig(), NewExtractionConfig(WithUseCache(false))} {
+		if withCacheKey(config) != config {
+			t.Errorf("expected %+v to be passed through so the native core keeps its cache location", config)
+		}
+	}
+}
+
+func TestCacheConfigDoesNotCreateDirectory(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cache")
+	cfg := cacheConfig(NewExtractionConfig(WithCacheDirectory(dir)))
+	if cfg.CacheDir == nil || filepath.Dir(*cfg.CacheDir) != dir {
+		t.Errorf("expected a keyed subdirectory of %s, got %+v", dir, cfg.CacheDir)
+	}
+	if _, err := os.Stat(dir); !os.IsNotExist(err) {
+		t.Errorf("expected the cache directory to be left to the native core, got %v", err)
+	}
+}
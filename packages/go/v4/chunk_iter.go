@@ -0,0 +1,88 @@
+package kreuzberg
+
+import (
+	"context"
+	"io"
+)
+
+// ChunkIterator yields the chunks of a document one at a time. It is created by
+// ExtractFileChunks and is not safe for concurrent use.
+type ChunkIterator struct {
+	ctx     context.Context
+	pending []Chunk
+	closed  bool
+}
+
+// ExtractFileChunks extracts and chunks the document at path and returns an
+// iterator over its chunks, for feeding them into a vector store one at a time:
+//
+//	chunks, err := kreuzberg.ExtractFileChunks(ctx, "large.pdf", config)
+//	if err != nil {
+//		return err
+//	}
+//	defer chunks.Close()
+//	for {
+//		chunk, err := chunks.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		index(chunk)
+//	}
+//
+// ExtractFileChunks is a convenience iterator and does not stream: the whole
+// document is extracted and chunked by a single ExtractFileWithContext call before
+// it returns, and every chunk is held in memory until Next hands it out. Use
+// ExtractFilePages to bound memory for large documents. Extraction errors are
+// reported immediately, and the Go-side hooks of config run as for
+// ExtractFileWithContext: ContentCallback, ContentValidator, and ProgressCallback
+// are called, OnComplete is not.
+//
+// Chunking uses config.Chunking, or the default chunking settings when it is nil,
+// and chunks carry their embedding when one is configured. The chunks keep the
+// metadata reported by the native core: ChunkIndex and TotalChunks count across
+// the whole document, FirstPage and LastPage name the pages a chunk spans, and
+// ByteStart and ByteEnd are offsets into the extracted text of the document. The
+// caller's config is not modified.
+func ExtractFileChunks(ctx context.Context, path string, config *ExtractionConfig) (*ChunkIterator, error) {
+	chunked := cloneConfig(config)
+	if chunked.Chunking == nil {
+		chunked.Chunking = &ChunkingConfig{}
+	}
+
+	result, err := extractFile(ctx, path, chunked)
+	if err != nil {
+		return nil, err
+	}
+	return &ChunkIterator{ctx: ctx, pending: result.Chunks}, nil
+}
+
+// Next returns the next chunk in document order. It returns io.EOF once every
+// chunk has been returned, and ctx.Err() when the context passed to
+// ExtractFileChunks is done.
+func (it *ChunkIterator) Next() (*Chunk, error) {
+	if it.closed {
+		return nil, newValidationErrorWithContext("chunk iterator is closed", nil, ErrorCodeValidation, nil)
+	}
+	if err := it.ctx.Err(); err != nil {
+		return nil, err
+	}
+	if len(it.pending) == 0 {
+		return nil, io.EOF
+	}
+
+	chunk := it.pending[0]
+	it.pending[0] = Chunk{}
+	it.pending = it.pending[1:]
+	return &chunk, nil
+}
+
+// Close releases the chunks that have not been returned yet. Calling Next after
+// Close returns an error; Close itself is idempotent.
+func (it *ChunkIterator) Close() error {
+	it.closed = true
+	it.pending = nil
+	return nil
+}
@@ -0,0 +1,75 @@
+package kreuzberg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func textChunk(content string, start, end, index uint64) Chunk {
+	return Chunk{Content: content, Metadata: ChunkMetadata{ByteStart: start, ByteEnd: end, ChunkIndex: index, TotalChunks: 2}}
+}
+
+func collectChunks(t *testing.T, it *ChunkIterator) []*Chunk {
+	t.Helper()
+	var chunks []*Chunk
+	for {
+		chunk, err := it.Next()
+		if err == io.EOF {
+			return chunks
+		}
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		chunks = append(chunks, chunk)
+	}
+}
+
+func TestChunkIterator(t *testing.T) {
+	page := uint64(2)
+	it := &ChunkIterator{
+		ctx: context.Background(),
+		pending: []Chunk{
+			textChunk("alpha", 0, 5, 0),
+			{Content: "beta", Embedding: []float32{0.5}, Metadata: ChunkMetadata{ByteStart: 6, ByteEnd: 10, ChunkIndex: 1, TotalChunks: 2, FirstPage: &page, LastPage: &page}},
+		},
+	}
+
+	chunks := collectChunks(t, it)
+	if len(chunks) != 2 {
+		t.Fatalf("expected 2 chunks, got %d", len(chunks))
+	}
+	last := chunks[1]
+	if last.Metadata.ChunkIndex != 1 || last.Metadata.ByteStart != 6 || last.Metadata.ByteEnd != 10 || last.Metadata.TotalChunks != 2 {
+		t.Errorf("expected native metadata to be kept, got %+v", last.Metadata)
+	}
+	if *last.Metadata.FirstPage != 2 || len(last.Embedding) != 1 {
+		t.Errorf("expected pages and embedding to be kept, got %+v", last)
+	}
+	if _, err := it.Next(); err != io.EOF {
+		t.Errorf("expected io.EOF after the last chunk, got %v", err)
+	}
+}
+
+func TestChunkIteratorCancelAndClose(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := &ChunkIterator{ctx: ctx, pending: []Chunk{textChunk("text", 0, 4, 0)}}
+	cancel()
+	if _, err := it.Next(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+
+	it.Close()
+	if _, err := it.Next(); err == nil {
+		t.Error("expected Next after Close to fail")
+	}
+}
+
+func TestExtractFileChunksCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ExtractFileChunks(ctx, "doc.pdf", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
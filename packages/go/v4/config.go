@@ -3,6 +3,7 @@ package kreuzberg
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"unsafe"
 )
 
@@ -171,6 +172,15 @@ func ConfigMerge(base, override *ExtractionConfig) error {
 	if override.Pages != nil {
 		base.Pages = override.Pages
 	}
+	if override.Spreadsheet != nil {
+		base.Spreadsheet = override.Spreadsheet
+	}
+	if override.Presentation != nil {
+		base.Presentation = override.Presentation
+	}
+	if override.Docx != nil {
+		base.Docx = override.Docx
+	}
 	if override.MaxConcurrentExtractions != nil {
 		base.MaxConcurrentExtractions = override.MaxConcurrentExtractions
 	}
@@ -180,6 +190,247 @@ func ConfigMerge(base, override *ExtractionConfig) error {
 	if override.ResultFormat != "" {
 		base.ResultFormat = override.ResultFormat
 	}
+	if override.ExtractTableOfContents != nil {
+		base.ExtractTableOfContents = override.ExtractTableOfContents
+	}
+	if override.InferTableHeaders != nil {
+		base.InferTableHeaders = override.InferTableHeaders
+	}
+	if override.DocumentType != nil {
+		base.DocumentType = override.DocumentType
+	}
+	if override.DocumentClassification != nil {
+		base.DocumentClassification = override.DocumentClassification
+	}
+	if override.DateExtraction != nil {
+		base.DateExtraction = override.DateExtraction
+	}
+	if override.StripEmoji != nil {
+		base.StripEmoji = override.StripEmoji
+	}
+	if override.StripSymbols != nil {
+		base.StripSymbols = override.StripSymbols
+	}
+	if override.TempDir != nil {
+		base.TempDir = override.TempDir
+	}
+	if override.ExtractComments != nil {
+		base.ExtractComments = override.ExtractComments
+	}
+	if override.ListDetection != nil {
+		base.ListDetection = override.ListDetection
+	}
+	if override.FootnoteLinking != nil {
+		base.FootnoteLinking = override.FootnoteLinking
+	}
+	if override.ResolveCrossReferences != nil {
+		base.ResolveCrossReferences = override.ResolveCrossReferences
+	}
+	if override.CaptionDetection != nil {
+		base.CaptionDetection = override.CaptionDetection
+	}
+	if override.InferMetadataFromContent != nil {
+		base.InferMetadataFromContent = override.InferMetadataFromContent
+	}
+	if override.PreserveLayout != nil {
+		base.PreserveLayout = override.PreserveLayout
+	}
+	if override.ScannedDetection != nil {
+		base.ScannedDetection = override.ScannedDetection
+	}
+	if override.ResourceTracking != nil {
+		base.ResourceTracking = override.ResourceTracking
+	}
+	if override.RedactImages != nil {
+		base.RedactImages = override.RedactImages
+	}
+	if override.InlineLinkStyle != "" {
+		base.InlineLinkStyle = override.InlineLinkStyle
+	}
+	if override.TablesInContent != nil {
+		base.TablesInContent = override.TablesInContent
+	}
+	if override.NormalizeNumbers != "" {
+		base.NormalizeNumbers = override.NormalizeNumbers
+	}
+	if override.SplitConcatenatedDocuments != nil {
+		base.SplitConcatenatedDocuments = override.SplitConcatenatedDocuments
+	}
+	if override.TablesOnly != nil {
+		base.TablesOnly = override.TablesOnly
+	}
+	if override.Embedding != nil {
+		base.Embedding = override.Embedding
+	}
+	if override.CacheDir != nil {
+		base.CacheDir = override.CacheDir
+	}
+	if override.CacheKeyStrategy != "" {
+		base.CacheKeyStrategy = override.CacheKeyStrategy
+	}
+	if override.MaxTablesPerPage != nil {
+		base.MaxTablesPerPage = override.MaxTablesPerPage
+	}
+	if override.ExtractChecksums != nil {
+		base.ExtractChecksums = override.ExtractChecksums
+	}
+	if override.HyphenationLanguage != "" {
+		base.HyphenationLanguage = override.HyphenationLanguage
+	}
+	if override.RubyHandling != "" {
+		base.RubyHandling = override.RubyHandling
+	}
+	if override.VerticalText != nil {
+		base.VerticalText = override.VerticalText
+	}
+	if override.ChartExtraction != nil {
+		base.ChartExtraction = override.ChartExtraction
+	}
+	if override.NormalizeConfusables != nil {
+		base.NormalizeConfusables = override.NormalizeConfusables
+	}
+	if override.ExtractXMP != nil {
+		base.ExtractXMP = override.ExtractXMP
+	}
+	if override.MaxContentBytes != nil {
+		base.MaxContentBytes = override.MaxContentBytes
+	}
+	if override.TruncationMarker != nil {
+		base.TruncationMarker = override.TruncationMarker
+	}
+	if override.PreserveListNumbering != nil {
+		base.PreserveListNumbering = override.PreserveListNumbering
+	}
+	if override.MergeParagraphsAcrossPages != nil {
+		base.MergeParagraphsAcrossPages = override.MergeParagraphsAcrossPages
+	}
+	if override.ContentCallback != nil {
+		base.ContentCallback = override.ContentCallback
+	}
+	if override.OnComplete != nil {
+		base.OnComplete = override.OnComplete
+	}
+	if override.ProgressCallback != nil {
+		base.ProgressCallback = override.ProgressCallback
+	}
+	if override.MaxReaderSize != nil {
+		base.MaxReaderSize = override.MaxReaderSize
+	}
+	if override.ContentValidator != nil {
+		base.ContentValidator = override.ContentValidator
+	}
 
 	return nil
 }
+
+// Merge returns a new config that layers override on top of c. Neither input is
+// modified, and the result shares no pointers, slices, or maps with them; only
+// callbacks are shared.
+//
+// Unlike ConfigMerge, Merge recurses into sub-configs: a field set in
+// override.OCR.Tesseract replaces only that field, keeping the rest of
+// c.OCR.Tesseract. The rules for each field are:
+//   - nil pointers, nil slices, nil maps, nil callbacks, and zero-valued plain
+//     fields (empty strings, false, 0) in override inherit the value from c;
+//   - non-nil pointers to sub-configs are merged field by field;
+//   - any other set value, including a pointer to false or 0, replaces c's;
+//   - a non-nil slice replaces c's slice wholesale rather than appending to it,
+//     so an empty non-nil slice clears it. The same applies to maps.
+//
+// Because plain fields such as FontConfig.Enabled cannot distinguish "unset"
+// from false, override cannot reset them to their zero value. A nil c or
+// override is treated as an empty config.
+func (c *ExtractionConfig) Merge(override *ExtractionConfig) *ExtractionConfig {
+	merged := &ExtractionConfig{}
+	if c != nil {
+		mergeValue(reflect.ValueOf(merged).Elem(), reflect.ValueOf(c).Elem())
+	}
+	if override != nil {
+		mergeValue(reflect.ValueOf(merged).Elem(), reflect.ValueOf(override).Elem())
+	}
+	return merged
+}
+
+// mergeValue layers src onto dst following the rules of ExtractionConfig.Merge,
+// copying everything it assigns so dst never aliases src.
+func mergeValue(dst, src reflect.Value) {
+	switch src.Kind() {
+	case reflect.Struct:
+		for i := 0; i < src.NumField(); i++ {
+			if src.Type().Field(i).IsExported() {
+				mergeValue(dst.Field(i), src.Field(i))
+			}
+		}
+	case reflect.Pointer:
+		if src.IsNil() {
+			return
+		}
+		if src.Elem().Kind() == reflect.Struct {
+			if dst.IsNil() {
+				dst.Set(reflect.New(src.Type().Elem()))
+			}
+			mergeValue(dst.Elem(), src.Elem())
+			return
+		}
+		dst.Set(copyValue(src))
+	case reflect.Slice, reflect.Map, reflect.Func, reflect.Interface:
+		if !src.IsNil() {
+			dst.Set(copyValue(src))
+		}
+	default:
+		if !src.IsZero() {
+			dst.Set(src)
+		}
+	}
+}
+
+// copyValue returns a deep copy of v: slices, maps, pointers, arrays, and the
+// exported fields of structs are copied recursively. Funcs and interfaces are
+// returned as is.
+func copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return s
+	case reflect.Array:
+		a := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			a.Index(i).Set(copyValue(v.Index(i)))
+		}
+		return a
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		m := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m.SetMapIndex(iter.Key(), copyValue(iter.Value()))
+		}
+		return m
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		ptr := reflect.New(v.Type().Elem())
+		ptr.Elem().Set(copyValue(v.Elem()))
+		return ptr
+	case reflect.Struct:
+		s := reflect.New(v.Type()).Elem()
+		s.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				s.Field(i).Set(copyValue(v.Field(i)))
+			}
+		}
+		return s
+	default:
+		return v
+	}
+}
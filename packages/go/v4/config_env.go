@@ -0,0 +1,130 @@
+package kreuzberg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+// configEnvVar maps an environment variable read by ConfigFromEnv to a config field.
+type configEnvVar struct {
+	name  string
+	apply func(c *ExtractionConfig, value string) error
+}
+
+// configEnvVars lists the environment variables read by ConfigFromEnv, in the
+// order they are documented there.
+var configEnvVars = []configEnvVar{
+	{"KREUZBERG_USE_CACHE", envBool(func(c *ExtractionConfig) **bool { return &c.UseCache })},
+	{"KREUZBERG_ENABLE_QUALITY_PROCESSING", envBool(func(c *ExtractionConfig) **bool { return &c.EnableQualityProcessing })},
+	{"KREUZBERG_FORCE_OCR", envBool(func(c *ExtractionConfig) **bool { return &c.ForceOCR })},
+	{"KREUZBERG_OCR_BACKEND", func(c *ExtractionConfig, value string) error {
+		envOCR(c).Backend = value
+		return nil
+	}},
+	{"KREUZBERG_OCR_LANGUAGE", func(c *ExtractionConfig, value string) error {
+		envOCR(c).Language = &value
+		return nil
+	}},
+	{"KREUZBERG_OUTPUT_FORMAT", func(c *ExtractionConfig, value string) error {
+		c.OutputFormat = value
+		return nil
+	}},
+	{"KREUZBERG_RESULT_FORMAT", func(c *ExtractionConfig, value string) error {
+		c.ResultFormat = value
+		return nil
+	}},
+	{"KREUZBERG_MAX_CONCURRENT_EXTRACTIONS", envInt(func(c *ExtractionConfig) **int { return &c.MaxConcurrentExtractions })},
+	{"KREUZBERG_TEMP_DIR", func(c *ExtractionConfig, value string) error {
+		c.TempDir = &value
+		return nil
+	}},
+	{"KREUZBERG_CHUNK_MAX_CHARS", envInt(func(c *ExtractionConfig) **int { return &envChunking(c).MaxChars })},
+	{"KREUZBERG_CHUNK_MAX_OVERLAP", envInt(func(c *ExtractionConfig) **int { return &envChunking(c).MaxOverlap })},
+}
+
+// ConfigFromEnv builds an ExtractionConfig from environment variables, for
+// twelve-factor deployments. The following variables are read:
+//
+//	KREUZBERG_USE_CACHE                   UseCache (boolean)
+//	KREUZBERG_ENABLE_QUALITY_PROCESSING   EnableQualityProcessing (boolean)
+//	KREUZBERG_FORCE_OCR                   ForceOCR (boolean)
+//	KREUZBERG_OCR_BACKEND                 OCR.Backend
+//	KREUZBERG_OCR_LANGUAGE                OCR.Language
+//	KREUZBERG_OUTPUT_FORMAT               OutputFormat
+//	KREUZBERG_RESULT_FORMAT               ResultFormat
+//	KREUZBERG_MAX_CONCURRENT_EXTRACTIONS  MaxConcurrentExtractions (integer)
+//	KREUZBERG_TEMP_DIR                    TempDir
+//	KREUZBERG_CHUNK_MAX_CHARS             Chunking.MaxChars (integer)
+//	KREUZBERG_CHUNK_MAX_OVERLAP           Chunking.MaxOverlap (integer)
+//
+// Booleans accept the forms of strconv.ParseBool, such as "true", "1", and
+// "false". Unset or empty variables leave their field nil or empty, and nested
+// configs are only allocated when one of their variables is set, so the result
+// can be merged over a base config:
+//
+//	envConfig, err := kreuzberg.ConfigFromEnv()
+//	if err != nil {
+//		return err
+//	}
+//	config := base.Merge(envConfig)
+//
+// Values that cannot be parsed are reported as a *ValidationError naming the
+// variable; the resulting config is then checked with ExtractionConfig.Validate.
+func ConfigFromEnv() (*ExtractionConfig, error) {
+	config := &ExtractionConfig{}
+	for _, v := range configEnvVars {
+		value, ok := os.LookupEnv(v.name)
+		if !ok || value == "" {
+			continue
+		}
+		if err := v.apply(config, value); err != nil {
+			return nil, newValidationErrorWithContext(fmt.Sprintf("invalid value for %s: %q (%v)", v.name, value, err), err, ErrorCodeValidation, nil)
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// envBool returns a setter that parses a boolean into the field returned by field.
+func envBool(field func(*ExtractionConfig) **bool) func(*ExtractionConfig, string) error {
+	return func(c *ExtractionConfig, value string) error {
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return errors.New("expected a boolean")
+		}
+		*field(c) = &parsed
+		return nil
+	}
+}
+
+// envInt returns a setter that parses an integer into the field returned by field.
+func envInt(field func(*ExtractionConfig) **int) func(*ExtractionConfig, string) error {
+	return func(c *ExtractionConfig, value string) error {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return errors.New("expected an integer")
+		}
+		*field(c) = &parsed
+		return nil
+	}
+}
+
+// envOCR returns c.OCR, allocating it when needed.
+func envOCR(c *ExtractionConfig) *OCRConfig {
+	if c.OCR == nil {
+		c.OCR = &OCRConfig{}
+	}
+	return c.OCR
+}
+
+// envChunking returns c.Chunking, allocating it when needed.
+func envChunking(c *ExtractionConfig) *ChunkingConfig {
+	if c.Chunking == nil {
+		c.Chunking = &ChunkingConfig{}
+	}
+	return c.Chunking
+}
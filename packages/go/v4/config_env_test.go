@@ -0,0 +1,53 @@
+package kreuzberg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	t.Setenv("KREUZBERG_USE_CACHE", "false")
+	t.Setenv("KREUZBERG_OCR_BACKEND", "tesseract")
+	t.Setenv("KREUZBERG_OCR_LANGUAGE", "deu")
+	t.Setenv("KREUZBERG_MAX_CONCURRENT_EXTRACTIONS", "4")
+	t.Setenv("KREUZBERG_FORCE_OCR", "")
+
+	config, err := ConfigFromEnv()
+	if err != nil {
+		t.Fatalf("ConfigFromEnv failed: %v", err)
+	}
+	if config.UseCache == nil || *config.UseCache {
+		t.Error("expected UseCache to be false")
+	}
+	if config.OCR == nil || config.OCR.Backend != "tesseract" || config.OCR.Language == nil || *config.OCR.Language != "deu" {
+		t.Errorf("unexpected OCR config: %+v", config.OCR)
+	}
+	if config.MaxConcurrentExtractions == nil || *config.MaxConcurrentExtractions != 4 {
+		t.Error("expected MaxConcurrentExtractions to be 4")
+	}
+	if config.ForceOCR != nil || config.EnableQualityProcessing != nil || config.Chunking != nil {
+		t.Error("expected unset and empty variables to leave their fields nil")
+	}
+
+	base := NewExtractionConfig(WithForceOCR(true), WithUseCache(true))
+	merged := base.Merge(config)
+	if !*merged.ForceOCR || *merged.UseCache {
+		t.Error("expected the env config to merge over the base config")
+	}
+}
+
+func TestConfigFromEnvInvalid(t *testing.T) {
+	t.Setenv("KREUZBERG_USE_CACHE", "sometimes")
+	_, err := ConfigFromEnv()
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) || !strings.Contains(err.Error(), "KREUZBERG_USE_CACHE") {
+		t.Errorf("expected a validation error naming the variable, got %v", err)
+	}
+
+	t.Setenv("KREUZBERG_USE_CACHE", "1")
+	t.Setenv("KREUZBERG_MAX_CONCURRENT_EXTRACTIONS", "-2")
+	if _, err := ConfigFromEnv(); err == nil {
+		t.Error("expected the resulting config to be validated")
+	}
+}
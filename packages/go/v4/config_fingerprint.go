@@ -0,0 +1,81 @@
+package kreuzberg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// Fingerprint returns a stable SHA-256 hex digest of the normalized config.
+//
+// The fingerprint covers exactly the fields that are serialized across the FFI
+// boundary, i.e. everything with a JSON tag. Go-side hooks such as ContentCallback
+// are excluded. Before hashing, the JSON form is normalized so that distinctions
+// which do not change extraction behavior produce the same fingerprint:
+//
+//   - object keys are sorted, so struct field order is irrelevant
+//   - null values and empty arrays are dropped, so nil pointers, unset fields,
+//     and nil or empty slices are equivalent
+//   - operational settings that do not affect output, TempDir and CacheDir,
+//     are dropped
+//
+// Empty sub-configs are kept: &OCRConfig{} or &ChunkingConfig{} enables a
+// feature with its defaults, so it does not hash the same as nil.
+//
+// A nil config returns the fingerprint of an empty config. When caching is
+// enabled with WithUseCache(true) or WithCacheDirectory, the extraction cache is
+// keyed on the fingerprint of the config sent to the native core, so cached
+// results are not served after the config changes.
+func (c *ExtractionConfig) Fingerprint() string {
+	normalized := []byte("{}")
+	if c != nil {
+		if data, err := json.Marshal(c); err == nil {
+			var tree any
+			if err := json.Unmarshal(data, &tree); err == nil {
+				if fields, ok := tree.(map[string]any); ok {
+					for _, key := range fingerprintIgnoredKeys {
+						delete(fields, key)
+					}
+				}
+				if pruned := pruneEmptyJSON(tree); pruned != nil {
+					if out, err := json.Marshal(pruned); err == nil {
+						normalized = out
+					}
+				}
+			}
+		}
+	}
+
+	sum := sha256.Sum256(normalized)
+	return hex.EncodeToString(sum[:])
+}
+
+// fingerprintIgnoredKeys lists top-level config keys excluded from Fingerprint.
+var fingerprintIgnoredKeys = []string{"temp_dir", "cache_dir"}
+
+// pruneEmptyJSON removes nulls and empty arrays from a decoded JSON tree. Objects
+// are kept even when empty. It returns nil when the value itself is null or an
+// empty array.
+func pruneEmptyJSON(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		for key, child := range v {
+			pruned := pruneEmptyJSON(child)
+			if pruned == nil {
+				delete(v, key)
+				continue
+			}
+			v[key] = pruned
+		}
+		return v
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+}
@@ -0,0 +1,100 @@
+package kreuzberg_test
+
+import (
+	"testing"
+
+	kreuzberg "github.com/kreuzberg-dev/kreuzberg/packages/go/v4"
+)
+
+func TestFingerprint_Stable(t *testing.T) {
+	a := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithUseCache(true),
+		kreuzberg.WithOCR(kreuzberg.WithOCRBackend("tesseract")),
+	)
+	b := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithOCR(kreuzberg.WithOCRBackend("tesseract")),
+		kreuzberg.WithUseCache(true),
+	)
+
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Error("expected identical configs to share a fingerprint")
+	}
+	if len(a.Fingerprint()) != 64 {
+		t.Errorf("expected 64 hex characters, got %d", len(a.Fingerprint()))
+	}
+}
+
+func TestFingerprint_IgnoresEmptyValues(t *testing.T) {
+	empty := &kreuzberg.ExtractionConfig{}
+	withEmptySlices := &kreuzberg.ExtractionConfig{
+		PdfOptions: &kreuzberg.PdfConfig{Passwords: []string{}},
+	}
+	withNilSlices := &kreuzberg.ExtractionConfig{
+		PdfOptions: &kreuzberg.PdfConfig{},
+	}
+	var nilConfig *kreuzberg.ExtractionConfig
+
+	if withEmptySlices.Fingerprint() != withNilSlices.Fingerprint() {
+		t.Error("expected empty slices to be equivalent to nil")
+	}
+	if empty.Fingerprint() != nilConfig.Fingerprint() {
+		t.Error("expected nil config to match empty config")
+	}
+}
+
+func TestFingerprint_KeepsEmptySubConfigs(t *testing.T) {
+	empty := &kreuzberg.ExtractionConfig{}
+	for name, config := range map[string]*kreuzberg.ExtractionConfig{
+		"chunking": {Chunking: &kreuzberg.ChunkingConfig{}},
+		"ocr":      {OCR: &kreuzberg.OCRConfig{}},
+	} {
+		if config.Fingerprint() == empty.Fingerprint() {
+			t.Errorf("expected an empty %s config to differ from nil", name)
+		}
+	}
+}
+
+func TestFingerprint_ChangesWithConfig(t *testing.T) {
+	a := kreuzberg.NewExtractionConfig(kreuzberg.WithForceOCR(true))
+	b := kreuzberg.NewExtractionConfig(kreuzberg.WithForceOCR(false))
+
+	if a.Fingerprint() == b.Fingerprint() {
+		t.Error("expected different configs to produce different fingerprints")
+	}
+}
+
+func TestFingerprint_IgnoresCallbacks(t *testing.T) {
+	a := kreuzberg.NewExtractionConfig(kreuzberg.WithUseCache(true))
+	b := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithUseCache(true),
+		kreuzberg.WithContentCallback(func(string, int) {}),
+	)
+
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Error("expected Go-side callbacks to be excluded from the fingerprint")
+	}
+}
+
+func TestFingerprint_IgnoresTempDir(t *testing.T) {
+	a := kreuzberg.NewExtractionConfig(kreuzberg.WithUseCache(true))
+	b := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithUseCache(true),
+		kreuzberg.WithTempDir("/var/tmp/kreuzberg"),
+	)
+
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Error("expected TempDir to be excluded from the fingerprint")
+	}
+}
+
+func TestFingerprint_IgnoresCacheDir(t *testing.T) {
+	a := kreuzberg.NewExtractionConfig(kreuzberg.WithUseCache(true))
+	b := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithUseCache(true),
+		kreuzberg.WithCacheDirectory("/var/cache/kreuzberg"),
+	)
+
+	if a.Fingerprint() != b.Fingerprint() {
+		t.Error("expected CacheDir to be excluded from the fingerprint")
+	}
+}
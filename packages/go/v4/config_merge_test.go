@@ -0,0 +1,22 @@
+package kreuzberg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCopyValueCopiesNestedPointers(t *testing.T) {
+	type item struct {
+		Size *int
+		Tags []string
+	}
+	size := 1
+	original := []*item{{Size: &size, Tags: []string{"a"}}}
+
+	copied := copyValue(reflect.ValueOf(original)).Interface().([]*item)
+	*copied[0].Size = 2
+	copied[0].Tags[0] = "b"
+	if *original[0].Size != 1 || original[0].Tags[0] != "a" {
+		t.Errorf("expected the copy to share no pointers or slices, got %+v", original[0])
+	}
+}
@@ -1,5 +1,7 @@
 package kreuzberg
 
+import "strings"
+
 // This file implements the functional options pattern for all Kreuzberg configuration types.
 // Instead of using pointer helper functions (BoolPtr, StringPtr, etc.), use the option
 // constructors defined below with NewXxxConfig functions.
@@ -10,7 +12,7 @@ package kreuzberg
 //		WithUseCache(false),
 //		WithEnableQualityProcessing(true),
 //		WithOCR(
-//			WithOCRBackend("tesseract"),
+//			WithOCRBackend(string(OCRBackendTesseract)),
 //			WithOCRLanguage("eng"),
 //		),
 //	)
@@ -29,6 +31,18 @@ func NewExtractionConfig(opts ...ExtractionOption) *ExtractionConfig {
 	return cfg
 }
 
+// NewExtractionConfigChecked is like NewExtractionConfig but validates the result
+// with ExtractionConfig.Validate, so invalid option values such as
+// WithTargetDPI(-5) are reported where the config is built rather than on the
+// first extraction. Checks implemented natively require the native library.
+func NewExtractionConfigChecked(opts ...ExtractionOption) (*ExtractionConfig, error) {
+	cfg := NewExtractionConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithUseCache sets whether caching is enabled.
 func WithUseCache(enabled bool) ExtractionOption {
 	return func(c *ExtractionConfig) {
@@ -64,6 +78,14 @@ func WithChunking(opts ...ChunkingOption) ExtractionOption {
 	}
 }
 
+// WithEmbedding sets the embedding configuration with functional options. When
+// set, every chunk produced by chunking carries its vector in Chunk.Embedding.
+func WithEmbedding(opts ...EmbeddingOption) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.Embedding = NewEmbeddingConfig(opts...)
+	}
+}
+
 // WithImages sets the image extraction configuration with functional options.
 func WithImages(opts ...ImageExtractionOption) ExtractionOption {
 	return func(c *ExtractionConfig) {
@@ -143,6 +165,431 @@ func WithResultFormat(format string) ExtractionOption {
 	}
 }
 
+// WithSpreadsheetOptions sets the spreadsheet configuration with functional options.
+func WithSpreadsheetOptions(opts ...SpreadsheetOption) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.Spreadsheet = NewSpreadsheetConfig(opts...)
+	}
+}
+
+// WithPresentationOptions sets the presentation configuration with functional options.
+func WithPresentationOptions(opts ...PresentationOption) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.Presentation = NewPresentationConfig(opts...)
+	}
+}
+
+// WithDocxOptions sets the DOCX configuration with functional options.
+func WithDocxOptions(opts ...DocxOption) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.Docx = NewDocxConfig(opts...)
+	}
+}
+
+// WithExtractTableOfContents asks the native core to report the document outline
+// in result.TOC. The flag is forwarded to the core as-is; result.TOC stays empty
+// when the core does not build one.
+func WithExtractTableOfContents(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ExtractTableOfContents = &enabled
+	}
+}
+
+// WithInferTableHeaders asks the native core to decide whether the first row of
+// each table is a header and record it in Table.HasHeader. It is forwarded as-is.
+func WithInferTableHeaders(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.InferTableHeaders = &enabled
+	}
+}
+
+// WithDocumentType passes a document kind hint (e.g. "invoice", "resume",
+// "contract") to the native core, which may use it to bias its defaults. The hint
+// is forwarded as-is; the Go side neither validates nor interprets it.
+func WithDocumentType(docType string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.DocumentType = &docType
+	}
+}
+
+// WithDocumentClassification asks the native core to classify the document and
+// report one of the DocumentType* labels in result.DetectedType. The flag is
+// forwarded as-is; DetectedType stays empty when the core does not classify.
+func WithDocumentClassification(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.DocumentClassification = &enabled
+	}
+}
+
+// WithDateExtraction reports absolute dates in the content in result.Dates,
+// normalized to RFC 3339. When the native core reports none, English and German
+// date notations are found on the Go side.
+func WithDateExtraction(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.DateExtraction = &enabled
+	}
+}
+
+// WithStripEmoji removes emoji and pictographic characters from the extracted text.
+// The number of removed characters is reported in result.StrippedCharCount.
+func WithStripEmoji(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.StripEmoji = &enabled
+	}
+}
+
+// WithStripSymbols removes characters in the Unicode symbol categories (Sm, Sc, Sk,
+// So) from the extracted text. This includes emoji as well as currency and math
+// symbols. The number of removed characters is reported in result.StrippedCharCount.
+func WithStripSymbols(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.StripSymbols = &enabled
+	}
+}
+
+// WithTempDir sets the directory for intermediate rendering and OCR files. Each
+// extraction uses its own subdirectory, removed when the call returns; when unset,
+// the native core uses its default temp location.
+func WithTempDir(dir string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.TempDir = &dir
+	}
+}
+
+// WithExtractComments requests comments and annotations from PDF, DOCX, and PPTX
+// documents in result.Annotations. The flag is passed to the native core as-is.
+func WithExtractComments(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ExtractComments = &enabled
+	}
+}
+
+// WithListDetection recognizes bullet ("•", "-", "*") and numbered ("1.", "a)",
+// "i.") list items and preserves their nesting. Markdown output renders them as
+// markdown lists; other output formats are not changed.
+func WithListDetection(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ListDetection = &enabled
+	}
+}
+
+// WithPreserveListNumbering keeps the original labels of numbered list items, such
+// as "a)" or "1.2.3", instead of renumbering them, for documents that refer to
+// their clause numbers.
+func WithPreserveListNumbering(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.PreserveListNumbering = &enabled
+	}
+}
+
+// WithFootnoteLinking asks the native core to link footnote markers to their text
+// and report them in result.Footnotes. It is forwarded unchanged.
+func WithFootnoteLinking(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.FootnoteLinking = &enabled
+	}
+}
+
+// WithResolveCrossReferences asks the native core to resolve references such as
+// "see Section 3.2" and report them, resolved or not, in result.CrossReferences.
+// The flag is forwarded as-is.
+func WithResolveCrossReferences(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ResolveCrossReferences = &enabled
+	}
+}
+
+// WithCaptionDetection asks the native core to attach figure and table captions
+// to ExtractedImage.Caption and Table.Caption. It is passed through unchanged.
+func WithCaptionDetection(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.CaptionDetection = &enabled
+	}
+}
+
+// WithInferMetadataFromContent fills missing title, author, and creation date
+// metadata from the first page's text, marked with MetadataSourceInferred. The
+// date is only inferred when WithDateExtraction is enabled too.
+func WithInferMetadataFromContent(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.InferMetadataFromContent = &enabled
+	}
+}
+
+// WithLayoutPreservation requests plain-text output padded to keep the horizontal
+// position of text, similar to "pdftotext -layout". It is forwarded to the native
+// core as-is.
+func WithLayoutPreservation(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.PreserveLayout = &enabled
+	}
+}
+
+// WithFirstPageOnly restricts the result to the first page by setting
+// Pages.PageNumbers to [1]; see PageConfig.PageNumbers. Passing false removes any
+// page restriction.
+func WithFirstPageOnly(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		if c.Pages == nil {
+			if !enabled {
+				return
+			}
+			c.Pages = &PageConfig{}
+		}
+		if enabled {
+			c.Pages.PageNumbers = []uint64{1}
+		} else {
+			c.Pages.PageNumbers = nil
+		}
+	}
+}
+
+// WithGenerateThumbnails asks the native core for a thumbnail of every page at dpi
+// in PageContent.Thumbnail and enables page extraction so the pages are returned.
+// A dpi of 0 or less turns thumbnails off again.
+func WithGenerateThumbnails(dpi int) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		if dpi <= 0 {
+			if c.Pages != nil {
+				c.Pages.ThumbnailDPI = nil
+			}
+			return
+		}
+		if c.Pages == nil {
+			c.Pages = &PageConfig{}
+		}
+		c.Pages.ExtractPages = BoolPtr(true)
+		c.Pages.ThumbnailDPI = &dpi
+	}
+}
+
+// WithScannedDetection asks the native core to classify the document as scanned or
+// born-digital in result.IsScanned and result.ScannedConfidence. It is passed
+// through unchanged.
+func WithScannedDetection(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ScannedDetection = &enabled
+	}
+}
+
+// WithResourceTracking reports peak memory, CPU time, and temporary disk usage
+// of each extraction in result.ResourceUsage, for cost attribution and spotting
+// pathological documents. See ResourceStats for how the numbers are measured.
+func WithResourceTracking(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ResourceTracking = &enabled
+	}
+}
+
+// WithRedactImages removes all images and image references from the output for
+// privacy, leaving surrounding markup and code blocks intact. The count is
+// reported in result.RedactedImageCount.
+func WithRedactImages(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.RedactImages = &enabled
+	}
+}
+
+// WithInlineLinkStyle controls how markdown output renders inline hyperlinks,
+// leaving code untouched. Options: "url_only", "text_only", "text_and_url".
+// Default: the format's native rendering.
+func WithInlineLinkStyle(style string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.InlineLinkStyle = style
+	}
+}
+
+// WithTablesInContent controls whether tables are also rendered inline in the
+// content and page texts; result.Tables is populated either way. Default: true.
+func WithTablesInContent(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.TablesInContent = &enabled
+	}
+}
+
+// WithNormalizeNumbers rewrites numbers in the conventions of locale, such as
+// "1.234,56" for "de", into canonical form ("1234.56") in the content, page texts,
+// and tables; see ValidateNumberLocale.
+func WithNormalizeNumbers(locale string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.NormalizeNumbers = locale
+	}
+}
+
+// WithSplitConcatenatedDocuments returns one result per form-feed-delimited
+// logical document in result.Children, as in mainframe text dumps; see
+// SplitOnFormFeed. Content keeps the whole text.
+func WithSplitConcatenatedDocuments(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.SplitConcatenatedDocuments = &enabled
+	}
+}
+
+// WithTablesOnly runs a fast path for table mining: only result.Tables is
+// returned, and text stages such as chunking and keyword extraction are skipped
+// even when configured.
+func WithTablesOnly(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.TablesOnly = &enabled
+	}
+}
+
+// WithCacheDirectory stores the extraction cache in dir, such as a volume shared
+// by several workers. Entries are kept per config Fingerprint, so a changed
+// config never hits entries cached under the old one.
+func WithCacheDirectory(dir string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.CacheDir = &dir
+	}
+}
+
+// WithCacheKeyStrategy sets how cache entries are keyed, by input bytes with
+// CacheKeyStrategyContentHash or by path and modification time with
+// CacheKeyStrategyPathMtime.
+func WithCacheKeyStrategy(strategy string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.CacheKeyStrategy = strategy
+	}
+}
+
+// WithMaxTablesPerPage keeps at most n tables per page, guarding against spurious
+// table detections; see DefaultMaxTablesPerPage. n must be positive.
+func WithMaxTablesPerPage(n int) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.MaxTablesPerPage = &n
+	}
+}
+
+// WithExtractChecksums sets ExtractedImage.Checksum on every extracted image to
+// the SHA-256 digest of its data, e.g. to deduplicate logos repeated across
+// documents. Checksums are computed on the Go side from the returned bytes.
+func WithExtractChecksums(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ExtractChecksums = &enabled
+	}
+}
+
+// WithHyphenationLanguage joins words hyphenated across lines in the content and
+// page texts, keeping hyphens that belong to the text by the rules of the language
+// code or HyphenationLanguageAuto; see ValidateHyphenationLanguage.
+func WithHyphenationLanguage(code string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.HyphenationLanguage = code
+	}
+}
+
+// WithRubyHandling sets how the native core extracts ruby annotations, such as
+// Japanese furigana; see RubyHandlingDrop, RubyHandlingInline, and
+// RubyHandlingSeparate. The mode is validated on the Go side and forwarded as-is.
+func WithRubyHandling(mode string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.RubyHandling = mode
+	}
+}
+
+// WithVerticalText asks the native core to read Japanese and Chinese vertical text
+// top to bottom and right to left. For Tesseract OCR, jpn, chi_sim, chi_tra, and
+// kor are also switched to their vertical models, which must be installed.
+func WithVerticalText(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.VerticalText = &enabled
+	}
+}
+
+// WithChartExtraction asks the native core to reconstruct the data of vector
+// charts with printed data labels into result.Charts. It is forwarded as-is.
+func WithChartExtraction(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ChartExtraction = &enabled
+	}
+}
+
+// WithNormalizeConfusables replaces look-alike letters in words that mix Latin,
+// Cyrillic, and Greek, such as a Cyrillic "а" in a Latin word, which break search
+// and are used in homoglyph attacks; see result.ConfusablesNormalized.
+func WithNormalizeConfusables(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.NormalizeConfusables = &enabled
+	}
+}
+
+// WithExtractXMP asks the native core for the XMP packet of PDFs and images in
+// result.XMPPacket. The packet's properties are flattened on the Go side into
+// result.XMP, keyed by prefixed names such as "dc:title".
+func WithExtractXMP(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ExtractXMP = &enabled
+	}
+}
+
+// WithMaxContentBytes truncates result.Content, but not pages, tables, or chunks,
+// to at most n bytes including the marker, at a character boundary; see
+// result.Truncated. n must be positive.
+func WithMaxContentBytes(n int) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.MaxContentBytes = &n
+	}
+}
+
+// WithTruncationMarker sets the text appended to Content when it is truncated by
+// WithMaxContentBytes. It defaults to DefaultTruncationMarker; an empty marker
+// truncates without appending anything.
+func WithTruncationMarker(marker string) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.TruncationMarker = &marker
+	}
+}
+
+// WithMergeParagraphsAcrossPages removes the paragraph break where a paragraph
+// continues onto the next page, judged by punctuation and case. Content with page
+// markers is left unchanged.
+func WithMergeParagraphsAcrossPages(enabled bool) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.MergeParagraphsAcrossPages = &enabled
+	}
+}
+
+// WithContentCallback registers a callback that receives each page's text while
+// the document is extracted; see ContentCallback.
+func WithContentCallback(callback func(partial string, page int)) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ContentCallback = callback
+	}
+}
+
+// WithOnComplete registers a callback that the batch APIs invoke exactly once per
+// input when it finishes; see CompletionCallback.
+func WithOnComplete(callback func(path string, result *ExtractionResult, err error)) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.OnComplete = callback
+	}
+}
+
+// WithMaxReaderSize limits the number of bytes ExtractReaderSync and
+// ExtractReaderWithContext read from their reader. Larger inputs fail with a
+// ValidationError instead of filling the disk.
+func WithMaxReaderSize(bytes int64) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.MaxReaderSize = &bytes
+	}
+}
+
+// WithContentValidator registers a check that every extraction must pass, such as
+// a minimum length or a required keyword; see ContentValidator.
+func WithContentValidator(validator func(result *ExtractionResult) error) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ContentValidator = validator
+	}
+}
+
+// WithProgressCallback registers a callback for the coarse progress events of
+// single-document extractions; see ProgressCallback.
+func WithProgressCallback(callback func(event ProgressEvent)) ExtractionOption {
+	return func(c *ExtractionConfig) {
+		c.ProgressCallback = callback
+	}
+}
+
 // ============================================================================
 // OCRConfig Options
 // ============================================================================
@@ -156,7 +603,19 @@ func NewOCRConfig(opts ...OCROption) *OCRConfig {
 	return cfg
 }
 
-// WithOCRBackend sets the OCR backend (e.g., "tesseract").
+// NewOCRConfigChecked is like NewOCRConfig but validates the result with
+// OCRConfig.Validate.
+func NewOCRConfigChecked(opts ...OCROption) (*OCRConfig, error) {
+	cfg := NewOCRConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// WithOCRBackend sets the OCR backend, preferably as one of the OCRBackend
+// constants. Names are checked against the built-in and registered backends
+// before the native call.
 func WithOCRBackend(backend string) OCROption {
 	return func(c *OCRConfig) {
 		c.Backend = backend
@@ -170,6 +629,50 @@ func WithOCRLanguage(lang string) OCROption {
 	}
 }
 
+// WithOCRLanguages sets several OCR languages, joined in Tesseract's "eng+deu"
+// form with the first as the primary one. Use NewOCRConfigChecked or
+// ExtractionConfig.Validate to check the codes.
+func WithOCRLanguages(langs ...string) OCROption {
+	return func(c *OCRConfig) {
+		joined := strings.Join(langs, "+")
+		c.Language = &joined
+	}
+}
+
+// WithMinTextLengthForOCR runs OCR on pages whose native text layer yields fewer
+// than chars characters. This catches PDFs with an almost empty text layer that
+// garbage detection does not flag.
+func WithMinTextLengthForOCR(chars int) OCROption {
+	return func(c *OCRConfig) {
+		c.MinTextLengthPerPage = &chars
+	}
+}
+
+// WithCharacterConfidence asks the native core for per-region OCR confidence in
+// result.ConfidenceHeatmap. The flag is forwarded as-is.
+func WithCharacterConfidence(enabled bool) OCROption {
+	return func(c *OCRConfig) {
+		c.CharacterConfidence = &enabled
+	}
+}
+
+// WithOCRBackends sets primary as the OCR backend and asks the native core to run
+// others alongside it and merge their output; see WithOCRMergeStrategy.
+func WithOCRBackends(primary string, others ...string) OCROption {
+	return func(c *OCRConfig) {
+		c.Backend = primary
+		c.AdditionalBackends = others
+	}
+}
+
+// WithOCRMergeStrategy sets how the native core merges output from multiple OCR
+// backends, e.g. OCRMergeStrategyBestConfidence.
+func WithOCRMergeStrategy(strategy string) OCROption {
+	return func(c *OCRConfig) {
+		c.MergeStrategy = strategy
+	}
+}
+
 // WithTesseract sets the Tesseract configuration with functional options.
 func WithTesseract(opts ...TesseractOption) OCROption {
 	return func(c *OCRConfig) {
@@ -177,6 +680,20 @@ func WithTesseract(opts ...TesseractOption) OCROption {
 	}
 }
 
+// WithPaddle sets the PaddleOCR configuration with functional options.
+func WithPaddle(opts ...PaddleOption) OCROption {
+	return func(c *OCRConfig) {
+		c.Paddle = NewPaddleConfig(opts...)
+	}
+}
+
+// WithEasyOCR sets the EasyOCR configuration with functional options.
+func WithEasyOCR(opts ...EasyOCROption) OCROption {
+	return func(c *OCRConfig) {
+		c.EasyOCR = NewEasyOCRConfig(opts...)
+	}
+}
+
 // ============================================================================
 // TesseractConfig Options
 // ============================================================================
@@ -190,6 +707,16 @@ func NewTesseractConfig(opts ...TesseractOption) *TesseractConfig {
 	return cfg
 }
 
+// NewTesseractConfigChecked is like NewTesseractConfig but validates the result with
+// TesseractConfig.Validate.
+func NewTesseractConfigChecked(opts ...TesseractOption) (*TesseractConfig, error) {
+	cfg := NewTesseractConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithTesseractLanguage sets the Tesseract language code.
 func WithTesseractLanguage(lang string) TesseractOption {
 	return func(c *TesseractConfig) {
@@ -302,20 +829,31 @@ func WithTesseractTesseditEnableDictCorrection(enabled bool) TesseractOption {
 	}
 }
 
-// WithTesseractTesseditCharWhitelist sets the character whitelist.
+// WithTesseractTesseditCharWhitelist restricts recognition to the characters of
+// whitelist. It must not share characters with the blacklist; see
+// WithTesseractTesseditCharBlacklist.
 func WithTesseractTesseditCharWhitelist(whitelist string) TesseractOption {
 	return func(c *TesseractConfig) {
 		c.TesseditCharWhitelist = whitelist
 	}
 }
 
-// WithTesseractTesseditCharBlacklist sets the character blacklist.
+// WithTesseractTesseditCharBlacklist forbids the characters of blacklist, e.g.
+// "|" on noisy scans. Characters also whitelisted are rejected before extraction.
 func WithTesseractTesseditCharBlacklist(blacklist string) TesseractOption {
 	return func(c *TesseractConfig) {
 		c.TesseditCharBlacklist = blacklist
 	}
 }
 
+// WithOCRWordList passes domain terms, such as drug names or part numbers, to the
+// native core as Tesseract's user-words list, biasing recognition toward them.
+func WithOCRWordList(words []string) TesseractOption {
+	return func(c *TesseractConfig) {
+		c.UserWords = append([]string(nil), words...)
+	}
+}
+
 // WithTesseractTesseditUsePrimaryParamsModel enables primary params model.
 func WithTesseractTesseditUsePrimaryParamsModel(enabled bool) TesseractOption {
 	return func(c *TesseractConfig) {
@@ -337,6 +875,130 @@ func WithTesseractThresholdingMethod(enabled bool) TesseractOption {
 	}
 }
 
+// ============================================================================
+// PaddleConfig Options
+// ============================================================================
+
+// NewPaddleConfig creates a new PaddleConfig with the given options.
+func NewPaddleConfig(opts ...PaddleOption) *PaddleConfig {
+	cfg := &PaddleConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// NewPaddleConfigChecked is like NewPaddleConfig but validates the result with
+// PaddleConfig.Validate.
+func NewPaddleConfigChecked(opts ...PaddleOption) (*PaddleConfig, error) {
+	cfg := NewPaddleConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// WithPaddleLanguage sets the PaddleOCR language, e.g. "en", "ch", or "japan".
+func WithPaddleLanguage(lang string) PaddleOption {
+	return func(c *PaddleConfig) {
+		c.Language = lang
+	}
+}
+
+// WithPaddleUseAngleCls sets whether the text direction classifier runs.
+func WithPaddleUseAngleCls(enabled bool) PaddleOption {
+	return func(c *PaddleConfig) {
+		c.UseAngleCls = &enabled
+	}
+}
+
+// WithPaddleDetModelDir loads the text detection model from dir.
+func WithPaddleDetModelDir(dir string) PaddleOption {
+	return func(c *PaddleConfig) {
+		c.DetModelDir = &dir
+	}
+}
+
+// WithPaddleRecModelDir loads the text recognition model from dir.
+func WithPaddleRecModelDir(dir string) PaddleOption {
+	return func(c *PaddleConfig) {
+		c.RecModelDir = &dir
+	}
+}
+
+// WithPaddleClsModelDir loads the text direction classification model from dir.
+func WithPaddleClsModelDir(dir string) PaddleOption {
+	return func(c *PaddleConfig) {
+		c.ClsModelDir = &dir
+	}
+}
+
+// WithPaddleMinConfidence drops recognized text lines below confidence (0.0-1.0).
+func WithPaddleMinConfidence(confidence float64) PaddleOption {
+	return func(c *PaddleConfig) {
+		c.MinConfidence = &confidence
+	}
+}
+
+// WithPaddleUseGPU sets whether inference runs on the GPU when one is available.
+func WithPaddleUseGPU(enabled bool) PaddleOption {
+	return func(c *PaddleConfig) {
+		c.UseGPU = &enabled
+	}
+}
+
+// ============================================================================
+// EasyOCRConfig Options
+// ============================================================================
+
+// NewEasyOCRConfig creates a new EasyOCRConfig with the given options.
+func NewEasyOCRConfig(opts ...EasyOCROption) *EasyOCRConfig {
+	cfg := &EasyOCRConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// NewEasyOCRConfigChecked is like NewEasyOCRConfig but validates the result with
+// EasyOCRConfig.Validate.
+func NewEasyOCRConfigChecked(opts ...EasyOCROption) (*EasyOCRConfig, error) {
+	cfg := NewEasyOCRConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// WithEasyOCRLanguages sets the languages EasyOCR recognizes. Each code is
+// checked with ValidateLanguageCode by EasyOCRConfig.Validate.
+func WithEasyOCRLanguages(langs []string) EasyOCROption {
+	return func(c *EasyOCRConfig) {
+		c.Languages = langs
+	}
+}
+
+// WithEasyOCRGPU sets whether EasyOCR runs on the GPU.
+func WithEasyOCRGPU(enabled bool) EasyOCROption {
+	return func(c *EasyOCRConfig) {
+		c.GPU = &enabled
+	}
+}
+
+// WithEasyOCRDevice selects the GPU device EasyOCR runs on, e.g. "cuda:1".
+func WithEasyOCRDevice(device string) EasyOCROption {
+	return func(c *EasyOCRConfig) {
+		c.Device = &device
+	}
+}
+
+// WithEasyOCRBatchSize sets the number of text regions recognized per batch.
+func WithEasyOCRBatchSize(size int) EasyOCROption {
+	return func(c *EasyOCRConfig) {
+		c.BatchSize = &size
+	}
+}
+
 // ============================================================================
 // ImagePreprocessingConfig Options
 // ============================================================================
@@ -350,6 +1012,16 @@ func NewImagePreprocessingConfig(opts ...ImagePreprocessingOption) *ImagePreproc
 	return cfg
 }
 
+// NewImagePreprocessingConfigChecked is like NewImagePreprocessingConfig but validates the
+// result with ImagePreprocessingConfig.Validate.
+func NewImagePreprocessingConfigChecked(opts ...ImagePreprocessingOption) (*ImagePreprocessingConfig, error) {
+	cfg := NewImagePreprocessingConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithTargetDPI sets the target DPI for image preprocessing.
 func WithTargetDPI(dpi int) ImagePreprocessingOption {
 	return func(c *ImagePreprocessingConfig) {
@@ -357,6 +1029,14 @@ func WithTargetDPI(dpi int) ImagePreprocessingOption {
 	}
 }
 
+// WithTargetDPIClamped sets the preprocessing target DPI, clamped into minDPI to
+// maxDPI instead of being rejected. Invalid bounds (minDPI <= 0 or minDPI > maxDPI)
+// fall back to DefaultMinDPI and DefaultMaxDPI.
+func WithTargetDPIClamped(dpi, minDPI, maxDPI int) ImagePreprocessingOption {
+	dpi = clampDPI(dpi, minDPI, maxDPI)
+	return WithTargetDPI(dpi)
+}
+
 // WithAutoRotate enables automatic rotation.
 func WithAutoRotate(enabled bool) ImagePreprocessingOption {
 	return func(c *ImagePreprocessingConfig) {
@@ -385,7 +1065,8 @@ func WithContrastEnhance(enabled bool) ImagePreprocessingOption {
 	}
 }
 
-// WithBinarizationMode sets the binarization method.
+// WithBinarizationMode sets the binarization method, e.g.
+// string(BinarizationModeOtsu).
 func WithBinarizationMode(mode string) ImagePreprocessingOption {
 	return func(c *ImagePreprocessingConfig) {
 		c.BinarizationMode = mode
@@ -412,6 +1093,16 @@ func NewChunkingConfig(opts ...ChunkingOption) *ChunkingConfig {
 	return cfg
 }
 
+// NewChunkingConfigChecked is like NewChunkingConfig but validates the result with
+// ChunkingConfig.Validate.
+func NewChunkingConfigChecked(opts ...ChunkingOption) (*ChunkingConfig, error) {
+	cfg := NewChunkingConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithMaxChars sets the maximum number of characters per chunk.
 func WithMaxChars(max int) ChunkingOption {
 	return func(c *ChunkingConfig) {
@@ -467,7 +1158,18 @@ func NewImageExtractionConfig(opts ...ImageExtractionOption) *ImageExtractionCon
 	return cfg
 }
 
-// WithExtractImages enables image extraction.
+// NewImageExtractionConfigChecked is like NewImageExtractionConfig but validates the
+// result with ImageExtractionConfig.Validate.
+func NewImageExtractionConfigChecked(opts ...ImageExtractionOption) (*ImageExtractionConfig, error) {
+	cfg := NewImageExtractionConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// WithExtractImages enables image extraction into result.Images and
+// PageContent.Images. When disabled, result.Images is always empty.
 func WithExtractImages(enabled bool) ImageExtractionOption {
 	return func(c *ImageExtractionConfig) {
 		c.ExtractImages = &enabled
@@ -509,6 +1211,14 @@ func WithMaxDPI(dpi int) ImageExtractionOption {
 	}
 }
 
+// WithExtractInlineImages also extracts PDF inline images, such as checkboxes and
+// stamps, reported in result.Images with Kind set to ImageKindInline.
+func WithExtractInlineImages(enabled bool) ImageExtractionOption {
+	return func(c *ImageExtractionConfig) {
+		c.ExtractInlineImages = &enabled
+	}
+}
+
 // ============================================================================
 // FontConfig Options
 // ============================================================================
@@ -549,6 +1259,16 @@ func NewPdfConfig(opts ...PdfOption) *PdfConfig {
 	return cfg
 }
 
+// NewPdfConfigChecked is like NewPdfConfig but validates the result with
+// PdfConfig.Validate.
+func NewPdfConfigChecked(opts ...PdfOption) (*PdfConfig, error) {
+	cfg := NewPdfConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithPdfExtractImages enables image extraction in PDFs.
 func WithPdfExtractImages(enabled bool) PdfOption {
 	return func(c *PdfConfig) {
@@ -577,6 +1297,47 @@ func WithPdfFontConfig(opts ...FontConfigOption) PdfOption {
 	}
 }
 
+// WithPdfHierarchy sets the font-size hierarchy configuration with functional options.
+func WithPdfHierarchy(opts ...HierarchyOption) PdfOption {
+	return func(c *PdfConfig) {
+		c.Hierarchy = NewHierarchyConfig(opts...)
+	}
+}
+
+// WithPdfFlattenForm asks the native core to flatten AcroForm fields into the page
+// content before rendering, so OCR sees filled-in values. It is forwarded as-is.
+func WithPdfFlattenForm(enabled bool) PdfOption {
+	return func(c *PdfConfig) {
+		c.FlattenForm = &enabled
+	}
+}
+
+// WithColumnGapThreshold sets the whitespace gap width, as a fraction of page
+// width, that counts as a column boundary during reading-order reconstruction.
+// The value must be in (0, 1]; see DefaultColumnGapThreshold.
+func WithColumnGapThreshold(fraction float64) PdfOption {
+	return func(c *PdfConfig) {
+		c.ColumnGapThreshold = &fraction
+	}
+}
+
+// WithExtractFontInfo reports the fonts used by the document, with their embedding
+// status and the pages they appear on, in result.Fonts.
+func WithExtractFontInfo(enabled bool) PdfOption {
+	return func(c *PdfConfig) {
+		c.ExtractFontInfo = &enabled
+	}
+}
+
+// WithPdfComplianceCheck requests PDF/A-related signals, such as the declared
+// conformance and font embedding, in result.PdfCompliance. It is forwarded to the
+// native core as-is and is not a full PDF/A validator.
+func WithPdfComplianceCheck(enabled bool) PdfOption {
+	return func(c *PdfConfig) {
+		c.ComplianceCheck = &enabled
+	}
+}
+
 // ============================================================================
 // TokenReductionConfig Options
 // ============================================================================
@@ -631,6 +1392,16 @@ func NewTokenReductionConfig(opts ...TokenReductionOption) *TokenReductionConfig
 	return cfg
 }
 
+// NewTokenReductionConfigChecked is like NewTokenReductionConfig but validates the
+// result with TokenReductionConfig.Validate.
+func NewTokenReductionConfigChecked(opts ...TokenReductionOption) (*TokenReductionConfig, error) {
+	cfg := NewTokenReductionConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithTokenReductionMode sets the token reduction mode.
 func WithTokenReductionMode(mode string) TokenReductionOption {
 	return func(c *TokenReductionConfig) {
@@ -658,6 +1429,16 @@ func NewLanguageDetectionConfig(opts ...LanguageDetectionOption) *LanguageDetect
 	return cfg
 }
 
+// NewLanguageDetectionConfigChecked is like NewLanguageDetectionConfig but validates the
+// result with LanguageDetectionConfig.Validate.
+func NewLanguageDetectionConfigChecked(opts ...LanguageDetectionOption) (*LanguageDetectionConfig, error) {
+	cfg := NewLanguageDetectionConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithLanguageDetectionEnabled enables language detection.
 func WithLanguageDetectionEnabled(enabled bool) LanguageDetectionOption {
 	return func(c *LanguageDetectionConfig) {
@@ -679,6 +1460,15 @@ func WithDetectMultiple(enabled bool) LanguageDetectionOption {
 	}
 }
 
+// WithKeepLanguages asks the native core to keep only content in the given ISO 639
+// language codes and report the rest in result.DroppedLanguageSections. The codes
+// are forwarded unchanged; no filtering happens on the Go side.
+func WithKeepLanguages(codes ...string) LanguageDetectionOption {
+	return func(c *LanguageDetectionConfig) {
+		c.KeepLanguages = codes
+	}
+}
+
 // ============================================================================
 // PostProcessorConfig Options
 // ============================================================================
@@ -801,6 +1591,31 @@ func WithEmbeddingBatchSize(size int) EmbeddingOption {
 	}
 }
 
+// WithEmbeddingConcurrency asks the native core to embed up to n batches of
+// BatchSize chunks in parallel. It is forwarded as-is.
+func WithEmbeddingConcurrency(n int) EmbeddingOption {
+	return func(c *EmbeddingConfig) {
+		c.Concurrency = &n
+	}
+}
+
+// WithEmbeddingMaxConcurrentRequests asks the native core to cap in-flight requests
+// to a remote embedding model at n. It has no effect on local models.
+func WithEmbeddingMaxConcurrentRequests(n int) EmbeddingOption {
+	return func(c *EmbeddingConfig) {
+		c.MaxConcurrentRequests = &n
+	}
+}
+
+// WithEmbeddingCache asks the native core to reuse the embeddings of chunks it has
+// embedded before with the same model. Hits and misses are reported in
+// result.EmbeddingCache when the core provides them.
+func WithEmbeddingCache(enabled bool) EmbeddingOption {
+	return func(c *EmbeddingConfig) {
+		c.Cache = &enabled
+	}
+}
+
 // WithShowDownloadProgress enables download progress display.
 func WithShowDownloadProgress(enabled bool) EmbeddingOption {
 	return func(c *EmbeddingConfig) {
@@ -839,6 +1654,16 @@ func NewKeywordConfig(opts ...KeywordOption) *KeywordConfig {
 	return cfg
 }
 
+// NewKeywordConfigChecked is like NewKeywordConfig but validates the result with
+// KeywordConfig.Validate.
+func NewKeywordConfigChecked(opts ...KeywordOption) (*KeywordConfig, error) {
+	cfg := NewKeywordConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithKeywordAlgorithm sets the keyword extraction algorithm.
 func WithKeywordAlgorithm(algorithm string) KeywordOption {
 	return func(c *KeywordConfig) {
@@ -1219,6 +2044,16 @@ func NewPageConfig(opts ...PageOption) *PageConfig {
 	return cfg
 }
 
+// NewPageConfigChecked is like NewPageConfig but validates the result with
+// PageConfig.Validate.
+func NewPageConfigChecked(opts ...PageOption) (*PageConfig, error) {
+	cfg := NewPageConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 // WithExtractPages enables page extraction.
 func WithExtractPages(enabled bool) PageOption {
 	return func(c *PageConfig) {
@@ -1239,3 +2074,93 @@ func WithMarkerFormat(format string) PageOption {
 		c.MarkerFormat = &format
 	}
 }
+
+// WithPageNumbers restricts the result to the given 1-indexed pages; see
+// PageConfig.PageNumbers.
+func WithPageNumbers(pages ...uint64) PageOption {
+	return func(c *PageConfig) {
+		c.PageNumbers = pages
+	}
+}
+
+// ============================================================================
+// SpreadsheetConfig Options
+// ============================================================================
+
+// NewSpreadsheetConfig creates a new SpreadsheetConfig with the given options.
+func NewSpreadsheetConfig(opts ...SpreadsheetOption) *SpreadsheetConfig {
+	// Provide default values matching Rust defaults
+	cfg := &SpreadsheetConfig{
+		FormulaMode: SpreadsheetFormulaModeValue,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// NewSpreadsheetConfigChecked is like NewSpreadsheetConfig but validates the result with
+// SpreadsheetConfig.Validate.
+func NewSpreadsheetConfigChecked(opts ...SpreadsheetOption) (*SpreadsheetConfig, error) {
+	cfg := NewSpreadsheetConfig(opts...)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// WithSpreadsheetFormulas requests the computed value, the formula string, or both
+// for formula cells. Options: "value", "formula", "both"
+func WithSpreadsheetFormulas(mode string) SpreadsheetOption {
+	return func(c *SpreadsheetConfig) {
+		c.FormulaMode = mode
+	}
+}
+
+// ============================================================================
+// PresentationConfig Options
+// ============================================================================
+
+// NewPresentationConfig creates a new PresentationConfig with the given options.
+func NewPresentationConfig(opts ...PresentationOption) *PresentationConfig {
+	cfg := &PresentationConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithIncludeSpeakerNotes requests speaker notes, attached per slide.
+func WithIncludeSpeakerNotes(enabled bool) PresentationOption {
+	return func(c *PresentationConfig) {
+		c.IncludeSpeakerNotes = &enabled
+	}
+}
+
+// WithSlidePerPage maps each slide to its own page and element.
+func WithSlidePerPage(enabled bool) PresentationOption {
+	return func(c *PresentationConfig) {
+		c.SlidePerPage = &enabled
+	}
+}
+
+// ============================================================================
+// DocxConfig Options
+// ============================================================================
+
+// NewDocxConfig creates a new DocxConfig with the given options.
+func NewDocxConfig(opts ...DocxOption) *DocxConfig {
+	cfg := &DocxConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithDocxRevisionHistory requests the tracked changes of Word documents in
+// result.Revisions. The flag is forwarded to the native core as-is.
+func WithDocxRevisionHistory(enabled bool) DocxOption {
+	return func(c *DocxConfig) {
+		c.RevisionHistory = &enabled
+	}
+}
@@ -391,6 +391,62 @@ func TestConfigMerge(t *testing.T) {
 	}
 }
 
+func TestExtractionConfigMerge(t *testing.T) {
+	base := &kreuzberg.ExtractionConfig{
+		UseCache:     kreuzberg.BoolPtr(true),
+		OutputFormat: "markdown",
+		OCR: &kreuzberg.OCRConfig{
+			Backend:   "tesseract",
+			Language:  kreuzberg.StringPtr("eng"),
+			Tesseract: &kreuzberg.TesseractConfig{PSM: kreuzberg.IntPtr(3)},
+		},
+		Pages: &kreuzberg.PageConfig{PageNumbers: []uint64{1, 2}},
+	}
+	override := &kreuzberg.ExtractionConfig{
+		UseCache: kreuzberg.BoolPtr(false),
+		OCR: &kreuzberg.OCRConfig{
+			Tesseract: &kreuzberg.TesseractConfig{OEM: kreuzberg.IntPtr(1)},
+		},
+		Pages:    &kreuzberg.PageConfig{PageNumbers: []uint64{5}},
+		Chunking: &kreuzberg.ChunkingConfig{MaxChars: kreuzberg.IntPtr(500)},
+	}
+
+	merged := base.Merge(override)
+
+	if merged.UseCache == nil || *merged.UseCache {
+		t.Error("expected pointer to false to override base")
+	}
+	if merged.OutputFormat != "markdown" {
+		t.Errorf("expected unset OutputFormat to inherit, got %q", merged.OutputFormat)
+	}
+	if merged.OCR.Backend != "tesseract" || merged.OCR.Language == nil || *merged.OCR.Language != "eng" {
+		t.Errorf("expected OCR fields to inherit, got %+v", merged.OCR)
+	}
+	tess := merged.OCR.Tesseract
+	if tess.PSM == nil || *tess.PSM != 3 || tess.OEM == nil || *tess.OEM != 1 {
+		t.Errorf("expected nested Tesseract fields to merge, got PSM=%v OEM=%v", tess.PSM, tess.OEM)
+	}
+	if len(merged.Pages.PageNumbers) != 1 || merged.Pages.PageNumbers[0] != 5 {
+		t.Errorf("expected slice to be replaced, got %v", merged.Pages.PageNumbers)
+	}
+	if merged.Chunking == nil || *merged.Chunking.MaxChars != 500 {
+		t.Error("expected sub-config missing from base to be taken from override")
+	}
+
+	*merged.OCR.Language = "deu"
+	merged.Pages.PageNumbers[0] = 9
+	if *base.OCR.Language != "eng" || override.Pages.PageNumbers[0] != 5 {
+		t.Error("expected merged config not to share memory with its inputs")
+	}
+	if base.OCR.Tesseract.OEM != nil || base.Chunking != nil {
+		t.Error("expected base config to be unchanged")
+	}
+
+	if got := (*kreuzberg.ExtractionConfig)(nil).Merge(nil); got == nil {
+		t.Error("expected nil configs to merge into an empty config")
+	}
+}
+
 func TestResultGetPageCount(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -513,6 +569,33 @@ func TestResultGetChunkCount(t *testing.T) {
 	}
 }
 
+func TestResultEmbeddings(t *testing.T) {
+	result := &kreuzberg.ExtractionResult{Chunks: []kreuzberg.Chunk{{Content: "a"}, {Content: "b"}}}
+	if result.Embeddings() != nil {
+		t.Error("expected no embeddings without vectors")
+	}
+
+	result.Chunks[1].Embedding = []float32{0.6, 0.8}
+	vectors := result.Embeddings()
+	if len(vectors) != 2 || vectors[0] != nil || len(vectors[1]) != 2 {
+		t.Errorf("expected vectors aligned with chunks, got %v", vectors)
+	}
+
+	config := kreuzberg.NewExtractionConfig(kreuzberg.WithEmbedding(kreuzberg.WithEmbeddingNormalize(true)))
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal config: %v", err)
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("failed to decode config JSON: %v", err)
+	}
+	embedding, ok := decoded["embedding"].(map[string]any)
+	if !ok || embedding["normalize"] != true {
+		t.Errorf("expected embedding settings in config JSON, got %s", data)
+	}
+}
+
 func TestResultGetDetectedLanguage(t *testing.T) {
 	tests := []struct {
 		name         string
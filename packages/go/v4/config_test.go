@@ -3,8 +3,12 @@ package kreuzberg_test
 import (
 	"bytes"
 	"encoding/json"
+	"reflect"
+	"strings"
 	"testing"
 
+	"gopkg.in/yaml.v3"
+
 	kreuzberg "github.com/kreuzberg-dev/kreuzberg/packages/go/v4"
 )
 
@@ -109,6 +113,64 @@ func TestExtractionConfig_FieldTags(t *testing.T) {
 	}
 }
 
+func TestExtractionConfig_WithDocumentType(t *testing.T) {
+	config := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithDocumentType("invoice"),
+	)
+
+	if config.DocumentType == nil || *config.DocumentType != "invoice" {
+		t.Fatal("expected DocumentType to be invoice")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(data) != `{"document_type":"invoice"}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
+
+func TestExtractionConfig_WithLayoutPreservation(t *testing.T) {
+	config := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithLayoutPreservation(true),
+	)
+
+	if config.PreserveLayout == nil || !*config.PreserveLayout {
+		t.Fatal("expected PreserveLayout to be true")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(data) != `{"preserve_layout":true}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
+
+func TestExtractionConfig_WithFirstPageOnly(t *testing.T) {
+	config := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithPages(kreuzberg.WithExtractPages(true)),
+		kreuzberg.WithFirstPageOnly(true),
+	)
+
+	if config.Pages == nil || len(config.Pages.PageNumbers) != 1 || config.Pages.PageNumbers[0] != 1 {
+		t.Fatalf("expected PageNumbers [1], got %+v", config.Pages)
+	}
+	if config.Pages.ExtractPages == nil || !*config.Pages.ExtractPages {
+		t.Error("expected existing page options to be preserved")
+	}
+
+	kreuzberg.WithFirstPageOnly(false)(config)
+	if config.Pages.PageNumbers != nil {
+		t.Error("expected page restriction to be removed")
+	}
+	if disabled := kreuzberg.NewExtractionConfig(kreuzberg.WithFirstPageOnly(false)); disabled.Pages != nil {
+		t.Error("expected no page config when first-page-only is disabled")
+	}
+}
+
 func TestExtractionConfig_WithForceOCR(t *testing.T) {
 	config := kreuzberg.NewExtractionConfig(
 		kreuzberg.WithForceOCR(true),
@@ -194,6 +256,81 @@ func TestOCRConfig_WithTesseract(t *testing.T) {
 	}
 }
 
+func TestOCRConfig_WithMinTextLengthForOCR(t *testing.T) {
+	config := kreuzberg.NewOCRConfig(
+		kreuzberg.WithMinTextLengthForOCR(50),
+	)
+
+	if config.MinTextLengthPerPage == nil || *config.MinTextLengthPerPage != 50 {
+		t.Fatal("expected MinTextLengthPerPage to be 50")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(data) != `{"min_text_length_per_page":50}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
+
+func TestOCRConfig_WithPaddle(t *testing.T) {
+	config := kreuzberg.NewOCRConfig(
+		kreuzberg.WithOCRBackend(string(kreuzberg.OCRBackendPaddleOCR)),
+		kreuzberg.WithPaddle(
+			kreuzberg.WithPaddleLanguage("japan"),
+			kreuzberg.WithPaddleUseAngleCls(true),
+			kreuzberg.WithPaddleRecModelDir("/models/rec"),
+		),
+	)
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	want := `{"backend":"paddleocr","paddle_config":{"language":"japan","use_angle_cls":true,"rec_model_dir":"/models/rec"}}`
+	if string(data) != want {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+
+	data, err = json.Marshal(kreuzberg.NewOCRConfig(kreuzberg.WithOCRBackend(string(kreuzberg.OCRBackendTesseract))))
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if strings.Contains(string(data), "paddle_config") {
+		t.Errorf("expected paddle_config to be omitted when nil, got %s", data)
+	}
+
+	if _, err := kreuzberg.NewPaddleConfigChecked(kreuzberg.WithPaddleDetModelDir("")); err == nil {
+		t.Error("expected error for empty model directory")
+	}
+}
+
+func TestOCRConfig_WithEasyOCR(t *testing.T) {
+	config := kreuzberg.NewOCRConfig(
+		kreuzberg.WithOCRBackend(string(kreuzberg.OCRBackendEasyOCR)),
+		kreuzberg.WithEasyOCR(
+			kreuzberg.WithEasyOCRLanguages([]string{"en", "ja"}),
+			kreuzberg.WithEasyOCRGPU(true),
+			kreuzberg.WithEasyOCRDevice("cuda:1"),
+			kreuzberg.WithEasyOCRBatchSize(16),
+		),
+	)
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	want := `{"backend":"easyocr","easyocr_config":{"languages":["en","ja"],"gpu":true,"device":"cuda:1","batch_size":16}}`
+	if string(data) != want {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+
+	if _, err := kreuzberg.NewEasyOCRConfigChecked(kreuzberg.WithEasyOCRBatchSize(0)); err == nil {
+		t.Error("expected error for non-positive batch size")
+	}
+}
+
 func TestOCRConfig_NilPointerHandling(t *testing.T) {
 	var config *kreuzberg.OCRConfig
 	_ = config
@@ -297,6 +434,24 @@ func TestTesseractConfig_CharWhitelist(t *testing.T) {
 	}
 }
 
+func TestTesseractConfig_WordList(t *testing.T) {
+	words := []string{"acetaminophen", "XR-2200"}
+	config := kreuzberg.NewTesseractConfig(kreuzberg.WithOCRWordList(words))
+	words[0] = "changed"
+
+	if len(config.UserWords) != 2 || config.UserWords[0] != "acetaminophen" {
+		t.Errorf("expected word list to be copied, got %v", config.UserWords)
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(data) != `{"user_words":["acetaminophen","XR-2200"]}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
+
 func TestTesseractConfig_NilPointerHandling(t *testing.T) {
 	var config *kreuzberg.TesseractConfig
 	_ = config
@@ -640,6 +795,22 @@ func TestPdfConfig_FunctionalOptions(t *testing.T) {
 	}
 }
 
+func TestPdfConfig_FlattenForm(t *testing.T) {
+	config := kreuzberg.NewPdfConfig(kreuzberg.WithPdfFlattenForm(true))
+
+	if config.FlattenForm == nil || !*config.FlattenForm {
+		t.Error("expected FlattenForm to be true")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal PdfConfig: %v", err)
+	}
+	if !bytes.Contains(data, []byte(`"flatten_form":true`)) {
+		t.Errorf("expected flatten_form in JSON, got %s", data)
+	}
+}
+
 func TestPdfConfig_JSON_Marshaling(t *testing.T) {
 	extractImages := true
 	original := &kreuzberg.PdfConfig{
@@ -1022,6 +1193,25 @@ func TestLanguageDetectionConfig_FunctionalOptions(t *testing.T) {
 	}
 }
 
+func TestLanguageDetectionConfig_KeepLanguages(t *testing.T) {
+	config := kreuzberg.NewLanguageDetectionConfig(
+		kreuzberg.WithLanguageDetectionEnabled(true),
+		kreuzberg.WithKeepLanguages("en"),
+	)
+
+	if len(config.KeepLanguages) != 1 || config.KeepLanguages[0] != "en" {
+		t.Errorf("expected KeepLanguages [en], got %v", config.KeepLanguages)
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal LanguageDetectionConfig: %v", err)
+	}
+	if !bytes.Contains(data, []byte(`"keep_languages":["en"]`)) {
+		t.Errorf("expected keep_languages in JSON, got %s", data)
+	}
+}
+
 func TestLanguageDetectionConfig_JSON_Marshaling(t *testing.T) {
 	enabled := true
 	original := &kreuzberg.LanguageDetectionConfig{
@@ -1173,6 +1363,33 @@ func TestEmbeddingConfig_FunctionalOptions(t *testing.T) {
 	}
 }
 
+func TestEmbeddingConfig_ConcurrencyAndCache(t *testing.T) {
+	config := kreuzberg.NewEmbeddingConfig(
+		kreuzberg.WithEmbeddingConcurrency(4),
+		kreuzberg.WithEmbeddingMaxConcurrentRequests(2),
+		kreuzberg.WithEmbeddingCache(true),
+	)
+
+	if config.Cache == nil || !*config.Cache {
+		t.Error("expected Cache to be true")
+	}
+
+	if config.Concurrency == nil || *config.Concurrency != 4 {
+		t.Error("expected Concurrency to be 4")
+	}
+	if config.MaxConcurrentRequests == nil || *config.MaxConcurrentRequests != 2 {
+		t.Error("expected MaxConcurrentRequests to be 2")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal EmbeddingConfig: %v", err)
+	}
+	if !strings.Contains(string(data), `"concurrency":4`) || !strings.Contains(string(data), `"max_concurrent_requests":2`) {
+		t.Errorf("expected concurrency settings in JSON, got %s", data)
+	}
+}
+
 func TestEmbeddingConfig_JSON_Marshaling(t *testing.T) {
 	normalize := true
 	original := &kreuzberg.EmbeddingConfig{
@@ -1398,6 +1615,111 @@ func TestComplexNestedConfig_JSON_Roundtrip(t *testing.T) {
 	}
 }
 
+func TestComplexNestedConfig_YAML_Roundtrip(t *testing.T) {
+	maxChars := 2000
+	enabled := true
+	dpi := 300
+
+	original := &kreuzberg.ExtractionConfig{
+		UseCache: &enabled,
+		OCR: &kreuzberg.OCRConfig{
+			Backend: "tesseract",
+			Tesseract: &kreuzberg.TesseractConfig{
+				Language: "eng",
+				Preprocessing: &kreuzberg.ImagePreprocessingConfig{
+					TargetDPI: &dpi,
+				},
+			},
+		},
+		Chunking: &kreuzberg.ChunkingConfig{
+			MaxChars: &maxChars,
+		},
+		ContentCallback: func(string, int) {},
+	}
+
+	data, err := yaml.Marshal(original)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	for _, omitted := range []string{"force_ocr", "content_callback", "null", "max_overlap"} {
+		if bytes.Contains(data, []byte(omitted)) {
+			t.Errorf("expected %q to be omitted, got:\n%s", omitted, data)
+		}
+	}
+
+	var restored kreuzberg.ExtractionConfig
+	if err := yaml.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("failed to unmarshal: %v", err)
+	}
+
+	if restored.UseCache == nil || *restored.UseCache != *original.UseCache {
+		t.Error("UseCache not preserved")
+	}
+	if restored.OCR == nil || restored.OCR.Backend != original.OCR.Backend {
+		t.Fatal("OCR Backend not preserved")
+	}
+	if restored.OCR.Tesseract == nil || restored.OCR.Tesseract.Language != original.OCR.Tesseract.Language {
+		t.Fatal("Tesseract Language not preserved")
+	}
+	if restored.OCR.Tesseract.Preprocessing == nil || *restored.OCR.Tesseract.Preprocessing.TargetDPI != *original.OCR.Tesseract.Preprocessing.TargetDPI {
+		t.Error("Preprocessing TargetDPI not preserved")
+	}
+	if restored.Chunking == nil || *restored.Chunking.MaxChars != *original.Chunking.MaxChars {
+		t.Error("Chunking MaxChars not preserved")
+	}
+	if restored.ForceOCR != nil || restored.Images != nil {
+		t.Error("expected omitted fields to stay nil")
+	}
+
+	// Explicit YAML nulls decode like omitted keys.
+	var nulls kreuzberg.ExtractionConfig
+	if err := yaml.Unmarshal([]byte("use_cache: null\nocr: ~\n"), &nulls); err != nil {
+		t.Fatalf("failed to unmarshal nulls: %v", err)
+	}
+	if nulls.UseCache != nil || nulls.OCR != nil {
+		t.Error("expected null values to decode as nil")
+	}
+}
+
+func TestConfigTypes_YAMLTagsMatchJSON(t *testing.T) {
+	root := reflect.TypeOf(kreuzberg.ExtractionConfig{})
+	seen := map[reflect.Type]bool{}
+	pending := []reflect.Type{root}
+
+	for len(pending) > 0 {
+		typ := pending[0]
+		pending = pending[1:]
+		if seen[typ] {
+			continue
+		}
+		seen[typ] = true
+
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if jsonTag, yamlTag := field.Tag.Get("json"), field.Tag.Get("yaml"); jsonTag != yamlTag {
+				t.Errorf("%s.%s: yaml tag %q does not match json tag %q", typ.Name(), field.Name, yamlTag, jsonTag)
+			}
+
+			nested := field.Type
+			for nested.Kind() == reflect.Pointer || nested.Kind() == reflect.Slice || nested.Kind() == reflect.Map {
+				nested = nested.Elem()
+			}
+			if nested.Kind() == reflect.Struct && nested.PkgPath() == root.PkgPath() {
+				pending = append(pending, nested)
+			}
+		}
+	}
+
+	for _, typ := range []reflect.Type{
+		reflect.TypeOf(kreuzberg.PaddleConfig{}),
+		reflect.TypeOf(kreuzberg.EasyOCRConfig{}),
+	} {
+		if !seen[typ] {
+			t.Errorf("%s is not reachable from ExtractionConfig", typ.Name())
+		}
+	}
+}
+
 func TestAllConfigTypes_CanBeNil(t *testing.T) {
 	configs := []interface{}{
 		(*kreuzberg.ExtractionConfig)(nil),
@@ -1667,3 +1989,95 @@ func TestOutputResultFormat_Combined(t *testing.T) {
 		t.Errorf("expected restored ResultFormat 'element_based', got %q", restored.ResultFormat)
 	}
 }
+
+// ============================================================================
+// SpreadsheetConfig Tests
+// ============================================================================
+
+func TestSpreadsheetConfig_DefaultFormulaMode(t *testing.T) {
+	config := kreuzberg.NewSpreadsheetConfig()
+
+	if config.FormulaMode != "value" {
+		t.Errorf("expected default FormulaMode to be value, got %s", config.FormulaMode)
+	}
+}
+
+func TestSpreadsheetConfig_FunctionalOptions(t *testing.T) {
+	config := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithSpreadsheetOptions(
+			kreuzberg.WithSpreadsheetFormulas("both"),
+		),
+	)
+
+	if config.Spreadsheet == nil {
+		t.Fatal("expected Spreadsheet to be set")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(data) != `{"spreadsheet":{"formula_mode":"both"}}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
+
+// ============================================================================
+// PresentationConfig Tests
+// ============================================================================
+
+func TestPresentationConfig_FunctionalOptions(t *testing.T) {
+	config := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithPresentationOptions(
+			kreuzberg.WithIncludeSpeakerNotes(true),
+			kreuzberg.WithSlidePerPage(true),
+		),
+	)
+
+	if config.Presentation == nil {
+		t.Fatal("expected Presentation to be set")
+	}
+	if config.Presentation.IncludeSpeakerNotes == nil || !*config.Presentation.IncludeSpeakerNotes {
+		t.Error("expected IncludeSpeakerNotes to be true")
+	}
+	if config.Presentation.SlidePerPage == nil || !*config.Presentation.SlidePerPage {
+		t.Error("expected SlidePerPage to be true")
+	}
+}
+
+func TestPresentationConfig_JSON_Marshaling(t *testing.T) {
+	config := kreuzberg.NewPresentationConfig(kreuzberg.WithIncludeSpeakerNotes(true))
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(data) != `{"include_speaker_notes":true}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
+
+// ============================================================================
+// DocxConfig Tests
+// ============================================================================
+
+func TestDocxConfig_FunctionalOptions(t *testing.T) {
+	config := kreuzberg.NewExtractionConfig(
+		kreuzberg.WithDocxOptions(kreuzberg.WithDocxRevisionHistory(true)),
+	)
+
+	if config.Docx == nil {
+		t.Fatal("expected Docx to be set")
+	}
+	if config.Docx.RevisionHistory == nil || !*config.Docx.RevisionHistory {
+		t.Error("expected RevisionHistory to be true")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal: %v", err)
+	}
+	if string(data) != `{"docx":{"revision_history":true}}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
@@ -0,0 +1,196 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// LoadConfigTOML reads an ExtractionConfig from the TOML file at path. Keys use
+// the same snake_case names as the Python and Rust configs, with nested tables for
+// nested configs:
+//
+//	use_cache = true
+//
+//	[ocr]
+//	backend = "tesseract"
+//	language = "eng"
+//
+//	[ocr.tesseract_config]
+//	psm = 6
+//
+//	[chunking]
+//	max_chars = 1000
+//	max_overlap = 100
+//
+// Unlike LoadExtractionConfigFromFile, parsing happens entirely on the Go side and
+// keys that do not correspond to a config field are reported as a
+// *ValidationError naming the key, instead of being ignored.
+func LoadConfigTOML(path string) (*ExtractionConfig, error) {
+	if path == "" {
+		return nil, newValidationErrorWithContext("config path cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, newIOErrorWithContext(fmt.Sprintf("failed to open config file %s", path), err, ErrorCodeIo, nil)
+	}
+	defer file.Close()
+
+	cfg, err := LoadConfigReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// LoadConfigReader reads a TOML document from r into an ExtractionConfig. See
+// LoadConfigTOML for the expected layout.
+func LoadConfigReader(r io.Reader) (*ExtractionConfig, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, newIOErrorWithContext("failed to read config", err, ErrorCodeIo, nil)
+	}
+	var doc map[string]any
+	if err := toml.Unmarshal(data, &doc); err != nil {
+		return nil, newValidationErrorWithContext("invalid TOML config: "+tomlErrorMessage(err), err, ErrorCodeValidation, nil)
+	}
+	if err := checkConfigKeys(reflect.TypeOf(ExtractionConfig{}), doc, ""); err != nil {
+		return nil, err
+	}
+
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return nil, newSerializationErrorWithContext("failed to encode TOML config", err, ErrorCodeValidation, nil)
+	}
+	cfg := &ExtractionConfig{}
+	if err := json.Unmarshal(encoded, cfg); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return nil, newValidationErrorWithContext(fmt.Sprintf("invalid value for config key %q: expected %s, got %s", typeErr.Field, typeErr.Type, typeErr.Value), err, ErrorCodeValidation, nil)
+		}
+		return nil, newValidationErrorWithContext("invalid TOML config: "+err.Error(), err, ErrorCodeValidation, nil)
+	}
+	return cfg, nil
+}
+
+// tomlErrorMessage returns the message of a TOML decoding error, prefixed with
+// the line and column it occurred at when known.
+func tomlErrorMessage(err error) string {
+	var decodeErr *toml.DecodeError
+	if errors.As(err, &decodeErr) {
+		row, column := decodeErr.Position()
+		return fmt.Sprintf("line %d, column %d: %s", row, column, decodeErr.Error())
+	}
+	return err.Error()
+}
+
+// checkConfigKeys reports the first key in doc, in sorted order, that has no
+// matching JSON field in the struct type t. Nested tables and arrays of tables
+// are checked recursively; path is the dotted key of doc.
+func checkConfigKeys(t reflect.Type, doc map[string]any, path string) error {
+	fields := jsonFields(t)
+	keys := make([]string, 0, len(doc))
+	for key := range doc {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyPath := joinTOMLPath([]string{path, key})
+		field, ok := fields[key]
+		if !ok {
+			return newValidationErrorWithContext(fmt.Sprintf("unknown config key %q%s", keyPath, knownKeysHint(fields)), nil, ErrorCodeValidation, nil)
+		}
+		if err := checkConfigValue(field, doc[key], keyPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkConfigValue checks the keys of nested tables in value against type t.
+func checkConfigValue(t reflect.Type, value any, path string) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		switch t.Kind() {
+		case reflect.Struct:
+			return checkConfigKeys(t, v, path)
+		case reflect.Map:
+			for key, item := range v {
+				if err := checkConfigValue(t.Elem(), item, joinTOMLPath([]string{path, key})); err != nil {
+					return err
+				}
+			}
+		}
+	case []any:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			for _, item := range v {
+				if err := checkConfigValue(t.Elem(), item, path); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// jsonFields maps the JSON names of t's serialized fields to their types.
+// Fields tagged json:"-" are excluded, and embedded structs are flattened.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for embedded, typ := range jsonFields(field.Type) {
+				fields[embedded] = typ
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// knownKeysHint lists the valid keys of small tables for error messages.
+func knownKeysHint(fields map[string]reflect.Type) string {
+	if len(fields) > 12 {
+		return ""
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return " (valid keys: " + strings.Join(names, ", ") + ")"
+}
+
+// joinTOMLPath joins key segments with dots, skipping empty segments.
+func joinTOMLPath(keys []string) string {
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key != "" {
+			parts = append(parts, key)
+		}
+	}
+	return strings.Join(parts, ".")
+}
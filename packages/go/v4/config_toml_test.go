@@ -0,0 +1,123 @@
+package kreuzberg
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoadConfigReader(t *testing.T) {
+	config, err := LoadConfigReader(strings.NewReader(`
+# Shared with the Python CLI.
+use_cache = true
+output_format = "markdown"
+
+[ocr]
+backend = "tesseract"
+language = 'eng'
+
+[ocr.tesseract_config]
+psm = 6
+min_confidence = 0.5
+
+[chunking]
+max_chars = 1_000
+max_overlap = 100
+
+[language_detection]
+enabled = true
+keep_languages = ["en", "de"]
+
+[pdf_options]
+font_config = { enabled = true, custom_font_dirs = ["/usr/share/fonts"] }
+`))
+	if err != nil {
+		t.Fatalf("LoadConfigReader failed: %v", err)
+	}
+
+	if config.UseCache == nil || !*config.UseCache || config.OutputFormat != "markdown" {
+		t.Errorf("unexpected top-level fields: %+v", config)
+	}
+	if config.OCR == nil || config.OCR.Backend != "tesseract" || *config.OCR.Language != "eng" {
+		t.Fatalf("unexpected OCR config: %+v", config.OCR)
+	}
+	if tess := config.OCR.Tesseract; tess == nil || *tess.PSM != 6 || *tess.MinConfidence != 0.5 {
+		t.Errorf("unexpected Tesseract config: %+v", tess)
+	}
+	if *config.Chunking.MaxChars != 1000 || *config.Chunking.MaxOverlap != 100 {
+		t.Errorf("unexpected chunking config: %+v", config.Chunking)
+	}
+	if !reflect.DeepEqual(config.LanguageDetection.KeepLanguages, []string{"en", "de"}) {
+		t.Errorf("unexpected languages: %v", config.LanguageDetection.KeepLanguages)
+	}
+	if fonts := config.PdfOptions.FontConfig; fonts == nil || !fonts.Enabled || len(fonts.CustomFontDirs) != 1 {
+		t.Errorf("unexpected font config: %+v", fonts)
+	}
+}
+
+func TestLoadConfigReaderUnknownKey(t *testing.T) {
+	for input, key := range map[string]string{
+		"use_cahce = true":                         `"use_cahce"`,
+		"[ocr]\nbackend = \"tesseract\"\nlang = 1": `"ocr.lang"`,
+		"[ocr.tesseract_config]\npsmm = 3":         `"ocr.tesseract_config.psmm"`,
+	} {
+		_, err := LoadConfigReader(strings.NewReader(input))
+		var valErr *ValidationError
+		if !errors.As(err, &valErr) {
+			t.Errorf("expected ValidationError for %q, got %v", input, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("expected error to name %s, got %v", key, err)
+		}
+	}
+}
+
+func TestLoadConfigReaderInvalid(t *testing.T) {
+	for _, input := range []string{
+		"use_cache = ",
+		"use_cache = true true",
+		"[ocr\nbackend = \"x\"",
+		"[ocr]\n[ocr]",
+		"use_cache = true\nuse_cache = false",
+		`output_format = "unterminated`,
+		"[chunking]\nmax_chars = \"many\"",
+		"[chunking]\nmax_chars = 007",
+	} {
+		_, err := LoadConfigReader(strings.NewReader(input))
+		var valErr *ValidationError
+		if !errors.As(err, &valErr) {
+			t.Errorf("expected ValidationError for %q, got %v", input, err)
+		}
+	}
+}
+
+func TestLoadConfigTOML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "kreuzberg.toml")
+	if err := os.WriteFile(path, []byte("force_ocr = true\n"), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	config, err := LoadConfigTOML(path)
+	if err != nil {
+		t.Fatalf("LoadConfigTOML failed: %v", err)
+	}
+	if config.ForceOCR == nil || !*config.ForceOCR {
+		t.Error("expected force_ocr to be true")
+	}
+
+	var ioErr *IOError
+	if _, err := LoadConfigTOML(filepath.Join(t.TempDir(), "missing.toml")); !errors.As(err, &ioErr) {
+		t.Errorf("expected IOError for missing file, got %v", err)
+	}
+}
+
+func TestLoadConfigReaderErrorLine(t *testing.T) {
+	_, err := LoadConfigReader(strings.NewReader("use_cache = true\nforce_ocr = false\noutput_format = ?\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("expected error on line 3, got %v", err)
+	}
+}
@@ -16,6 +16,12 @@ type OCROption func(*OCRConfig)
 // TesseractOption is a functional option for configuring TesseractConfig.
 type TesseractOption func(*TesseractConfig)
 
+// PaddleOption is a functional option for configuring PaddleConfig.
+type PaddleOption func(*PaddleConfig)
+
+// EasyOCROption is a functional option for configuring EasyOCRConfig.
+type EasyOCROption func(*EasyOCRConfig)
+
 // ImagePreprocessingOption is a functional option for configuring ImagePreprocessingConfig.
 type ImagePreprocessingOption func(*ImagePreprocessingConfig)
 
@@ -67,228 +73,532 @@ type HTMLConversionOption func(*HTMLConversionOptions)
 // PageOption is a functional option for configuring PageConfig.
 type PageOption func(*PageConfig)
 
+// SpreadsheetOption is a functional option for configuring SpreadsheetConfig.
+type SpreadsheetOption func(*SpreadsheetConfig)
+
+// PresentationOption is a functional option for configuring PresentationConfig.
+type PresentationOption func(*PresentationConfig)
+
+// DocxOption is a functional option for configuring DocxConfig.
+type DocxOption func(*DocxConfig)
+
+// ContentCallback receives the text of a single page as extraction proceeds.
+// Page numbers are 1-indexed.
+//
+// When ExtractionConfig.ContentCallback is set, ExtractFileSync, ExtractBytesSync,
+// and their context variants first extract the document page by page, as
+// ExtractFilePages does, and pass each page's text to the callback as soon as
+// that page is extracted. The per-page extractions skip chunking, embeddings,
+// keywords, language detection, and classification, which then run once in the
+// full extraction that follows. Every page is therefore extracted twice, and
+// the whole document once per page when the native core does not honor
+// PageConfig.PageNumbers. The batch APIs do not call the callback.
+type ContentCallback func(partial string, page int)
+
+// CompletionCallback is notified when one input of a batch extraction finishes.
+// When the input failed, err is non-nil and result is nil.
+//
+// Calls are serialized, so the callback needs no locking of its own.
+// BatchExtractFilesSeq and BatchExtractFilesPool report each file as it finishes,
+// possibly out of input order. BatchExtractFilesSync, BatchExtractBytesSync, and
+// the Items variants report the inputs in input order after the native batch has
+// returned. Byte batches pass the input's index in decimal, e.g. "0", as the path.
+type CompletionCallback func(path string, result *ExtractionResult, err error)
+
+// ContentValidator inspects a finished extraction result after all Go-side
+// processing. A non-nil error rejects the result: the call fails with a
+// ValidationError wrapping it, so errors.Is and errors.As still match. Batch calls
+// fail as a whole when any result is rejected, and ExtractFilePages validates
+// each page separately.
+type ContentValidator func(result *ExtractionResult) error
+
 // ExtractionConfig mirrors the Rust ExtractionConfig structure and is serialized to JSON
 // before crossing the FFI boundary. Use pointer fields to omit values and rely on Kreuzberg
 // defaults whenever possible.
 type ExtractionConfig struct {
-	UseCache                 *bool                    `json:"use_cache,omitempty"`
-	EnableQualityProcessing  *bool                    `json:"enable_quality_processing,omitempty"`
-	OCR                      *OCRConfig               `json:"ocr,omitempty"`
-	ForceOCR                 *bool                    `json:"force_ocr,omitempty"`
-	Chunking                 *ChunkingConfig          `json:"chunking,omitempty"`
-	Images                   *ImageExtractionConfig   `json:"images,omitempty"`
-	PdfOptions               *PdfConfig               `json:"pdf_options,omitempty"`
-	TokenReduction           *TokenReductionConfig    `json:"token_reduction,omitempty"`
-	LanguageDetection        *LanguageDetectionConfig `json:"language_detection,omitempty"`
-	Keywords                 *KeywordConfig           `json:"keywords,omitempty"`
-	Postprocessor            *PostProcessorConfig     `json:"postprocessor,omitempty"`
-	HTMLOptions              *HTMLConversionOptions   `json:"html_options,omitempty"`
-	Pages                    *PageConfig              `json:"pages,omitempty"`
-	MaxConcurrentExtractions *int                     `json:"max_concurrent_extractions,omitempty"`
-	OutputFormat             string                   `json:"output_format,omitempty"`
-	ResultFormat             string                   `json:"result_format,omitempty"`
+	UseCache                   *bool                    `json:"use_cache,omitempty" yaml:"use_cache,omitempty"`
+	EnableQualityProcessing    *bool                    `json:"enable_quality_processing,omitempty" yaml:"enable_quality_processing,omitempty"`
+	OCR                        *OCRConfig               `json:"ocr,omitempty" yaml:"ocr,omitempty"`
+	ForceOCR                   *bool                    `json:"force_ocr,omitempty" yaml:"force_ocr,omitempty"`
+	Chunking                   *ChunkingConfig          `json:"chunking,omitempty" yaml:"chunking,omitempty"`
+	Embedding                  *EmbeddingConfig         `json:"embedding,omitempty" yaml:"embedding,omitempty"`
+	Images                     *ImageExtractionConfig   `json:"images,omitempty" yaml:"images,omitempty"`
+	PdfOptions                 *PdfConfig               `json:"pdf_options,omitempty" yaml:"pdf_options,omitempty"`
+	TokenReduction             *TokenReductionConfig    `json:"token_reduction,omitempty" yaml:"token_reduction,omitempty"`
+	LanguageDetection          *LanguageDetectionConfig `json:"language_detection,omitempty" yaml:"language_detection,omitempty"`
+	Keywords                   *KeywordConfig           `json:"keywords,omitempty" yaml:"keywords,omitempty"`
+	Postprocessor              *PostProcessorConfig     `json:"postprocessor,omitempty" yaml:"postprocessor,omitempty"`
+	HTMLOptions                *HTMLConversionOptions   `json:"html_options,omitempty" yaml:"html_options,omitempty"`
+	Pages                      *PageConfig              `json:"pages,omitempty" yaml:"pages,omitempty"`
+	Spreadsheet                *SpreadsheetConfig       `json:"spreadsheet,omitempty" yaml:"spreadsheet,omitempty"`
+	Presentation               *PresentationConfig      `json:"presentation,omitempty" yaml:"presentation,omitempty"`
+	Docx                       *DocxConfig              `json:"docx,omitempty" yaml:"docx,omitempty"`
+	MaxConcurrentExtractions   *int                     `json:"max_concurrent_extractions,omitempty" yaml:"max_concurrent_extractions,omitempty"`
+	OutputFormat               string                   `json:"output_format,omitempty" yaml:"output_format,omitempty"`
+	ResultFormat               string                   `json:"result_format,omitempty" yaml:"result_format,omitempty"`
+	ExtractTableOfContents     *bool                    `json:"extract_table_of_contents,omitempty" yaml:"extract_table_of_contents,omitempty"`
+	InferTableHeaders          *bool                    `json:"infer_table_headers,omitempty" yaml:"infer_table_headers,omitempty"`
+	DocumentType               *string                  `json:"document_type,omitempty" yaml:"document_type,omitempty"`
+	DocumentClassification     *bool                    `json:"document_classification,omitempty" yaml:"document_classification,omitempty"`
+	DateExtraction             *bool                    `json:"date_extraction,omitempty" yaml:"date_extraction,omitempty"`
+	StripEmoji                 *bool                    `json:"strip_emoji,omitempty" yaml:"strip_emoji,omitempty"`
+	StripSymbols               *bool                    `json:"strip_symbols,omitempty" yaml:"strip_symbols,omitempty"`
+	TempDir                    *string                  `json:"temp_dir,omitempty" yaml:"temp_dir,omitempty"`
+	ExtractComments            *bool                    `json:"extract_comments,omitempty" yaml:"extract_comments,omitempty"`
+	ListDetection              *bool                    `json:"list_detection,omitempty" yaml:"list_detection,omitempty"`
+	PreserveListNumbering      *bool                    `json:"preserve_list_numbering,omitempty" yaml:"preserve_list_numbering,omitempty"`
+	MergeParagraphsAcrossPages *bool                    `json:"merge_paragraphs_across_pages,omitempty" yaml:"merge_paragraphs_across_pages,omitempty"`
+	FootnoteLinking            *bool                    `json:"footnote_linking,omitempty" yaml:"footnote_linking,omitempty"`
+	ResolveCrossReferences     *bool                    `json:"resolve_cross_references,omitempty" yaml:"resolve_cross_references,omitempty"`
+	CaptionDetection           *bool                    `json:"caption_detection,omitempty" yaml:"caption_detection,omitempty"`
+	InferMetadataFromContent   *bool                    `json:"infer_metadata_from_content,omitempty" yaml:"infer_metadata_from_content,omitempty"`
+	PreserveLayout             *bool                    `json:"preserve_layout,omitempty" yaml:"preserve_layout,omitempty"`
+	ScannedDetection           *bool                    `json:"scanned_detection,omitempty" yaml:"scanned_detection,omitempty"`
+	ResourceTracking           *bool                    `json:"resource_tracking,omitempty" yaml:"resource_tracking,omitempty"`
+	RedactImages               *bool                    `json:"redact_images,omitempty" yaml:"redact_images,omitempty"`
+	InlineLinkStyle            string                   `json:"inline_link_style,omitempty" yaml:"inline_link_style,omitempty"`
+	TablesInContent            *bool                    `json:"tables_in_content,omitempty" yaml:"tables_in_content,omitempty"`
+	NormalizeNumbers           string                   `json:"normalize_numbers,omitempty" yaml:"normalize_numbers,omitempty"`
+	SplitConcatenatedDocuments *bool                    `json:"split_concatenated_documents,omitempty" yaml:"split_concatenated_documents,omitempty"`
+	TablesOnly                 *bool                    `json:"tables_only,omitempty" yaml:"tables_only,omitempty"`
+	CacheDir                   *string                  `json:"cache_dir,omitempty" yaml:"cache_dir,omitempty"`
+	CacheKeyStrategy           string                   `json:"cache_key_strategy,omitempty" yaml:"cache_key_strategy,omitempty"`
+	MaxTablesPerPage           *int                     `json:"max_tables_per_page,omitempty" yaml:"max_tables_per_page,omitempty"`
+	ExtractChecksums           *bool                    `json:"extract_checksums,omitempty" yaml:"extract_checksums,omitempty"`
+	HyphenationLanguage        string                   `json:"hyphenation_language,omitempty" yaml:"hyphenation_language,omitempty"`
+	RubyHandling               string                   `json:"ruby_handling,omitempty" yaml:"ruby_handling,omitempty"`
+	VerticalText               *bool                    `json:"vertical_text,omitempty" yaml:"vertical_text,omitempty"`
+	ChartExtraction            *bool                    `json:"chart_extraction,omitempty" yaml:"chart_extraction,omitempty"`
+	NormalizeConfusables       *bool                    `json:"normalize_confusables,omitempty" yaml:"normalize_confusables,omitempty"`
+	ExtractXMP                 *bool                    `json:"extract_xmp,omitempty" yaml:"extract_xmp,omitempty"`
+	MaxContentBytes            *int                     `json:"max_content_bytes,omitempty" yaml:"max_content_bytes,omitempty"`
+	TruncationMarker           *string                  `json:"truncation_marker,omitempty" yaml:"truncation_marker,omitempty"`
+
+	// ContentCallback is invoked once per page with that page's text as the
+	// extraction proceeds. It is a Go-side hook and is never serialized across
+	// the FFI boundary.
+	ContentCallback ContentCallback `json:"-" yaml:"-"`
+	// OnComplete is invoked once per input by the batch APIs; see WithOnComplete.
+	// Like ContentCallback, it is never serialized across the FFI boundary.
+	OnComplete CompletionCallback `json:"-" yaml:"-"`
+	// MaxReaderSize limits how many bytes ExtractReaderSync reads from its reader.
+	// It is enforced on the Go side and is never serialized.
+	MaxReaderSize *int64 `json:"-" yaml:"-"`
+	// ContentValidator accepts or rejects each result after all Go-side
+	// processing. Like ContentCallback, it is never serialized.
+	ContentValidator ContentValidator `json:"-" yaml:"-"`
+	// ProgressCallback receives progress events of single-document extractions.
+	// Like ContentCallback, it is never serialized.
+	ProgressCallback ProgressCallback `json:"-" yaml:"-"`
 }
 
+// Inline link styles accepted by ExtractionConfig.InlineLinkStyle.
+const (
+	// InlineLinkStyleURLOnly renders a link as its URL.
+	InlineLinkStyleURLOnly = "url_only"
+	// InlineLinkStyleTextOnly renders a link as its anchor text.
+	InlineLinkStyleTextOnly = "text_only"
+	// InlineLinkStyleTextAndURL renders a link as its anchor text followed by its URL.
+	InlineLinkStyleTextAndURL = "text_and_url"
+)
+
+// DefaultMaxTablesPerPage is the number of tables kept per page when
+// ExtractionConfig.MaxTablesPerPage is unset. The tables with the highest
+// Confidence are kept in their original order; dropped tables are removed from the
+// content and counted in result.Warnings. Tables without a page number, such as
+// those of DOCX, HTML, and spreadsheet documents, are never dropped.
+const DefaultMaxTablesPerPage = 100
+
+// Ruby handling modes accepted by ExtractionConfig.RubyHandling.
+const (
+	// RubyHandlingDrop removes ruby text, keeping only the base text.
+	RubyHandlingDrop = "drop"
+	// RubyHandlingInline writes ruby text in parentheses after its base text,
+	// e.g. "漢字(かんじ)".
+	RubyHandlingInline = "inline"
+	// RubyHandlingSeparate removes ruby text from the content and reports it in
+	// result.RubyAnnotations.
+	RubyHandlingSeparate = "separate"
+)
+
+// DefaultMinDPI and DefaultMaxDPI bound the resolutions accepted by ClampDPI:
+// below 72 DPI text is too coarse to recognize, and above 600 DPI rendering cost
+// grows without improving OCR accuracy.
+const (
+	DefaultMinDPI = 72
+	DefaultMaxDPI = 600
+)
+
+// DefaultTruncationMarker is appended to Content when it is truncated to
+// ExtractionConfig.MaxContentBytes and ExtractionConfig.TruncationMarker is unset.
+const DefaultTruncationMarker = "[...truncated]"
+
 // OCRConfig selects and configures OCR backends.
 type OCRConfig struct {
-	Backend   string           `json:"backend,omitempty"`
-	Language  *string          `json:"language,omitempty"`
-	Tesseract *TesseractConfig `json:"tesseract_config,omitempty"`
+	Backend   string           `json:"backend,omitempty" yaml:"backend,omitempty"`
+	Language  *string          `json:"language,omitempty" yaml:"language,omitempty"`
+	Tesseract *TesseractConfig `json:"tesseract_config,omitempty" yaml:"tesseract_config,omitempty"`
+	// Paddle configures the PaddleOCR backend; it is used when Backend or one of
+	// AdditionalBackends is OCRBackendPaddleOCR.
+	Paddle *PaddleConfig `json:"paddle_config,omitempty" yaml:"paddle_config,omitempty"`
+	// EasyOCR configures the EasyOCR backend; it is used when Backend or one of
+	// AdditionalBackends is OCRBackendEasyOCR.
+	EasyOCR *EasyOCRConfig `json:"easyocr_config,omitempty" yaml:"easyocr_config,omitempty"`
+
+	// MinTextLengthPerPage triggers OCR for pages whose native text layer yields
+	// fewer characters than this threshold. Default: null (disabled).
+	MinTextLengthPerPage *int `json:"min_text_length_per_page,omitempty" yaml:"min_text_length_per_page,omitempty"`
+
+	// CharacterConfidence asks the native core to aggregate per-character OCR
+	// confidence into result.ConfidenceHeatmap. Default: false.
+	CharacterConfidence *bool `json:"character_confidence,omitempty" yaml:"character_confidence,omitempty"`
+
+	// AdditionalBackends are forwarded to the native core to run alongside Backend
+	// on every OCRed page, combined according to MergeStrategy.
+	AdditionalBackends []string `json:"additional_backends,omitempty" yaml:"additional_backends,omitempty"`
+	// MergeStrategy selects how output from several backends is combined.
+	// Default: OCRMergeStrategyBestConfidence when AdditionalBackends is set.
+	MergeStrategy string `json:"merge_strategy,omitempty" yaml:"merge_strategy,omitempty"`
 }
 
+// OCRBackend names an OCR backend, as accepted by OCRConfig.Backend and
+// OCRConfig.AdditionalBackends.
+type OCRBackend string
+
+// OCR backends built into the native core.
+const (
+	// OCRBackendTesseract selects Tesseract.
+	OCRBackendTesseract OCRBackend = "tesseract"
+	// OCRBackendEasyOCR selects EasyOCR.
+	OCRBackendEasyOCR OCRBackend = "easyocr"
+	// OCRBackendPaddleOCR selects PaddleOCR.
+	OCRBackendPaddleOCR OCRBackend = "paddleocr"
+)
+
+// OCR merge strategies accepted by OCRConfig.MergeStrategy.
+const (
+	// OCRMergeStrategyBestConfidence keeps, for each word, the recognition with
+	// the highest confidence across backends.
+	OCRMergeStrategyBestConfidence = "best_confidence"
+)
+
 // TesseractConfig exposes fine-grained controls for the Tesseract backend.
 type TesseractConfig struct {
-	Language                       string                    `json:"language,omitempty"`
-	PSM                            *int                      `json:"psm,omitempty"`
-	OutputFormat                   string                    `json:"output_format,omitempty"`
-	OEM                            *int                      `json:"oem,omitempty"`
-	MinConfidence                  *float64                  `json:"min_confidence,omitempty"`
-	Preprocessing                  *ImagePreprocessingConfig `json:"preprocessing,omitempty"`
-	EnableTableDetection           *bool                     `json:"enable_table_detection,omitempty"`
-	TableMinConfidence             *float64                  `json:"table_min_confidence,omitempty"`
-	TableColumnThreshold           *int                      `json:"table_column_threshold,omitempty"`
-	TableRowThresholdRatio         *float64                  `json:"table_row_threshold_ratio,omitempty"`
-	UseCache                       *bool                     `json:"use_cache,omitempty"`
-	ClassifyUsePreAdaptedTemplates *bool                     `json:"classify_use_pre_adapted_templates,omitempty"`
-	LanguageModelNgramOn           *bool                     `json:"language_model_ngram_on,omitempty"`
-	TesseditDontBlkrejGoodWds      *bool                     `json:"tessedit_dont_blkrej_good_wds,omitempty"`
-	TesseditDontRowrejGoodWds      *bool                     `json:"tessedit_dont_rowrej_good_wds,omitempty"`
-	TesseditEnableDictCorrection   *bool                     `json:"tessedit_enable_dict_correction,omitempty"`
-	TesseditCharWhitelist          string                    `json:"tessedit_char_whitelist,omitempty"`
-	TesseditCharBlacklist          string                    `json:"tessedit_char_blacklist,omitempty"`
-	TesseditUsePrimaryParamsModel  *bool                     `json:"tessedit_use_primary_params_model,omitempty"`
-	TextordSpaceSizeIsVariable     *bool                     `json:"textord_space_size_is_variable,omitempty"`
-	ThresholdingMethod             *bool                     `json:"thresholding_method,omitempty"`
+	Language                       string                    `json:"language,omitempty" yaml:"language,omitempty"`
+	PSM                            *int                      `json:"psm,omitempty" yaml:"psm,omitempty"`
+	OutputFormat                   string                    `json:"output_format,omitempty" yaml:"output_format,omitempty"`
+	OEM                            *int                      `json:"oem,omitempty" yaml:"oem,omitempty"`
+	MinConfidence                  *float64                  `json:"min_confidence,omitempty" yaml:"min_confidence,omitempty"`
+	Preprocessing                  *ImagePreprocessingConfig `json:"preprocessing,omitempty" yaml:"preprocessing,omitempty"`
+	EnableTableDetection           *bool                     `json:"enable_table_detection,omitempty" yaml:"enable_table_detection,omitempty"`
+	TableMinConfidence             *float64                  `json:"table_min_confidence,omitempty" yaml:"table_min_confidence,omitempty"`
+	TableColumnThreshold           *int                      `json:"table_column_threshold,omitempty" yaml:"table_column_threshold,omitempty"`
+	TableRowThresholdRatio         *float64                  `json:"table_row_threshold_ratio,omitempty" yaml:"table_row_threshold_ratio,omitempty"`
+	UseCache                       *bool                     `json:"use_cache,omitempty" yaml:"use_cache,omitempty"`
+	ClassifyUsePreAdaptedTemplates *bool                     `json:"classify_use_pre_adapted_templates,omitempty" yaml:"classify_use_pre_adapted_templates,omitempty"`
+	LanguageModelNgramOn           *bool                     `json:"language_model_ngram_on,omitempty" yaml:"language_model_ngram_on,omitempty"`
+	TesseditDontBlkrejGoodWds      *bool                     `json:"tessedit_dont_blkrej_good_wds,omitempty" yaml:"tessedit_dont_blkrej_good_wds,omitempty"`
+	TesseditDontRowrejGoodWds      *bool                     `json:"tessedit_dont_rowrej_good_wds,omitempty" yaml:"tessedit_dont_rowrej_good_wds,omitempty"`
+	TesseditEnableDictCorrection   *bool                     `json:"tessedit_enable_dict_correction,omitempty" yaml:"tessedit_enable_dict_correction,omitempty"`
+	TesseditCharWhitelist          string                    `json:"tessedit_char_whitelist,omitempty" yaml:"tessedit_char_whitelist,omitempty"`
+	TesseditCharBlacklist          string                    `json:"tessedit_char_blacklist,omitempty" yaml:"tessedit_char_blacklist,omitempty"`
+	TesseditUsePrimaryParamsModel  *bool                     `json:"tessedit_use_primary_params_model,omitempty" yaml:"tessedit_use_primary_params_model,omitempty"`
+	TextordSpaceSizeIsVariable     *bool                     `json:"textord_space_size_is_variable,omitempty" yaml:"textord_space_size_is_variable,omitempty"`
+	ThresholdingMethod             *bool                     `json:"thresholding_method,omitempty" yaml:"thresholding_method,omitempty"`
+	// UserWords is a domain word list, such as drug names or part numbers, passed
+	// to the native core for Tesseract's user-words list.
+	UserWords []string `json:"user_words,omitempty" yaml:"user_words,omitempty"`
+}
+
+// PaddleConfig exposes fine-grained controls for the PaddleOCR backend.
+type PaddleConfig struct {
+	// Language is the PaddleOCR language, e.g. "en", "ch", or "japan". Default:
+	// derived from OCRConfig.Language.
+	Language string `json:"language,omitempty" yaml:"language,omitempty"`
+	// UseAngleCls runs the text direction classifier, so rotated text lines are
+	// recognized. Default: true.
+	UseAngleCls *bool `json:"use_angle_cls,omitempty" yaml:"use_angle_cls,omitempty"`
+	// DetModelDir, RecModelDir, and ClsModelDir load the detection, recognition,
+	// and direction classification models from local directories instead of the
+	// bundled models, e.g. for fine-tuned or offline deployments.
+	DetModelDir *string `json:"det_model_dir,omitempty" yaml:"det_model_dir,omitempty"`
+	RecModelDir *string `json:"rec_model_dir,omitempty" yaml:"rec_model_dir,omitempty"`
+	ClsModelDir *string `json:"cls_model_dir,omitempty" yaml:"cls_model_dir,omitempty"`
+	// MinConfidence drops recognized text lines below this confidence (0.0-1.0).
+	MinConfidence *float64 `json:"min_confidence,omitempty" yaml:"min_confidence,omitempty"`
+	// UseGPU runs inference on the GPU when one is available. Default: false.
+	UseGPU *bool `json:"use_gpu,omitempty" yaml:"use_gpu,omitempty"`
+}
+
+// EasyOCRConfig exposes fine-grained controls for the EasyOCR backend.
+type EasyOCRConfig struct {
+	// Languages lists the language codes EasyOCR recognizes, e.g. "en" and "ja".
+	// Default: derived from OCRConfig.Language.
+	Languages []string `json:"languages,omitempty" yaml:"languages,omitempty"`
+	// GPU runs inference on the GPU. Default: true when a GPU is available.
+	GPU *bool `json:"gpu,omitempty" yaml:"gpu,omitempty"`
+	// Device selects the GPU device when GPU is enabled, e.g. "cuda:1" or "mps".
+	Device *string `json:"device,omitempty" yaml:"device,omitempty"`
+	// BatchSize is the number of text regions recognized per inference batch;
+	// larger batches raise GPU throughput at the cost of memory. Default: 1.
+	BatchSize *int `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
 }
 
 // ImagePreprocessingConfig tunes DPI normalization and related steps for OCR.
 type ImagePreprocessingConfig struct {
-	TargetDPI        *int   `json:"target_dpi,omitempty"`
-	AutoRotate       *bool  `json:"auto_rotate,omitempty"`
-	Deskew           *bool  `json:"deskew,omitempty"`
-	Denoise          *bool  `json:"denoise,omitempty"`
-	ContrastEnhance  *bool  `json:"contrast_enhance,omitempty"`
-	BinarizationMode string `json:"binarization_method,omitempty"`
-	InvertColors     *bool  `json:"invert_colors,omitempty"`
+	TargetDPI        *int   `json:"target_dpi,omitempty" yaml:"target_dpi,omitempty"`
+	AutoRotate       *bool  `json:"auto_rotate,omitempty" yaml:"auto_rotate,omitempty"`
+	Deskew           *bool  `json:"deskew,omitempty" yaml:"deskew,omitempty"`
+	Denoise          *bool  `json:"denoise,omitempty" yaml:"denoise,omitempty"`
+	ContrastEnhance  *bool  `json:"contrast_enhance,omitempty" yaml:"contrast_enhance,omitempty"`
+	BinarizationMode string `json:"binarization_method,omitempty" yaml:"binarization_method,omitempty"`
+	InvertColors     *bool  `json:"invert_colors,omitempty" yaml:"invert_colors,omitempty"`
 }
 
+// BinarizationMode names a binarization method, as accepted by
+// ImagePreprocessingConfig.BinarizationMode.
+type BinarizationMode string
+
+const (
+	// BinarizationModeOtsu applies a global threshold chosen with Otsu's method.
+	BinarizationModeOtsu BinarizationMode = "otsu"
+	// BinarizationModeAdaptive thresholds each pixel against its neighborhood mean.
+	BinarizationModeAdaptive BinarizationMode = "adaptive"
+	// BinarizationModeSauvola applies Sauvola's local threshold, which copes with
+	// uneven lighting and stained backgrounds.
+	BinarizationModeSauvola BinarizationMode = "sauvola"
+)
+
 // ChunkingConfig configures text chunking for downstream RAG/Retrieval workloads.
 type ChunkingConfig struct {
-	MaxChars     *int    `json:"max_chars,omitempty"`
-	MaxOverlap   *int    `json:"max_overlap,omitempty"`
-	ChunkSize    *int    `json:"chunk_size,omitempty"`
-	ChunkOverlap *int    `json:"chunk_overlap,omitempty"`
-	Preset       *string `json:"preset,omitempty"`
-	Enabled      *bool   `json:"enabled,omitempty"`
+	MaxChars     *int    `json:"max_chars,omitempty" yaml:"max_chars,omitempty"`
+	MaxOverlap   *int    `json:"max_overlap,omitempty" yaml:"max_overlap,omitempty"`
+	ChunkSize    *int    `json:"chunk_size,omitempty" yaml:"chunk_size,omitempty"`
+	ChunkOverlap *int    `json:"chunk_overlap,omitempty" yaml:"chunk_overlap,omitempty"`
+	Preset       *string `json:"preset,omitempty" yaml:"preset,omitempty"`
+	Enabled      *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 }
 
 // ImageExtractionConfig controls inline image extraction from PDFs/Office docs.
 type ImageExtractionConfig struct {
-	ExtractImages     *bool `json:"extract_images,omitempty"`
-	TargetDPI         *int  `json:"target_dpi,omitempty"`
-	MaxImageDimension *int  `json:"max_image_dimension,omitempty"`
-	AutoAdjustDPI     *bool `json:"auto_adjust_dpi,omitempty"`
-	MinDPI            *int  `json:"min_dpi,omitempty"`
-	MaxDPI            *int  `json:"max_dpi,omitempty"`
+	ExtractImages     *bool `json:"extract_images,omitempty" yaml:"extract_images,omitempty"`
+	TargetDPI         *int  `json:"target_dpi,omitempty" yaml:"target_dpi,omitempty"`
+	MaxImageDimension *int  `json:"max_image_dimension,omitempty" yaml:"max_image_dimension,omitempty"`
+	AutoAdjustDPI     *bool `json:"auto_adjust_dpi,omitempty" yaml:"auto_adjust_dpi,omitempty"`
+	MinDPI            *int  `json:"min_dpi,omitempty" yaml:"min_dpi,omitempty"`
+	MaxDPI            *int  `json:"max_dpi,omitempty" yaml:"max_dpi,omitempty"`
+	// ExtractInlineImages also extracts PDF inline images, drawn with the BI/ID/EI
+	// operators rather than as image XObjects; see WithExtractInlineImages.
+	ExtractInlineImages *bool `json:"extract_inline_images,omitempty" yaml:"extract_inline_images,omitempty"`
 }
 
 // FontConfig exposes font provider configuration for PDF extraction.
 type FontConfig struct {
-	Enabled        bool     `json:"enabled"`
-	CustomFontDirs []string `json:"custom_font_dirs,omitempty"`
+	Enabled        bool     `json:"enabled" yaml:"enabled"`
+	CustomFontDirs []string `json:"custom_font_dirs,omitempty" yaml:"custom_font_dirs,omitempty"`
 }
 
 // PdfConfig exposes PDF-specific options.
 type PdfConfig struct {
-	ExtractImages   *bool       `json:"extract_images,omitempty"`
-	Passwords       []string    `json:"passwords,omitempty"`
-	ExtractMetadata *bool       `json:"extract_metadata,omitempty"`
-	FontConfig      *FontConfig `json:"font_config,omitempty"`
+	ExtractImages   *bool       `json:"extract_images,omitempty" yaml:"extract_images,omitempty"`
+	Passwords       []string    `json:"passwords,omitempty" yaml:"passwords,omitempty"`
+	ExtractMetadata *bool       `json:"extract_metadata,omitempty" yaml:"extract_metadata,omitempty"`
+	FontConfig      *FontConfig `json:"font_config,omitempty" yaml:"font_config,omitempty"`
+	// Hierarchy enables font-size hierarchy extraction, reported per page in
+	// PageContent.Hierarchy. It requires page extraction.
+	Hierarchy *HierarchyConfig `json:"hierarchy,omitempty" yaml:"hierarchy,omitempty"`
+	// FlattenForm requests that the native core paint AcroForm field values into
+	// the page content before rendering.
+	FlattenForm *bool `json:"flatten_form,omitempty" yaml:"flatten_form,omitempty"`
+	// ColumnGapThreshold is the minimum width of a vertical whitespace gap, as a
+	// fraction of page width (0.0-1.0], that reading-order reconstruction treats as
+	// a column boundary. Raise it when single wide columns are split in two.
+	// Default: DefaultColumnGapThreshold.
+	ColumnGapThreshold *float64 `json:"column_gap_threshold,omitempty" yaml:"column_gap_threshold,omitempty"`
+	// ExtractFontInfo reports the fonts used by the document in result.Fonts.
+	ExtractFontInfo *bool `json:"extract_font_info,omitempty" yaml:"extract_font_info,omitempty"`
+	// ComplianceCheck reports archival compliance signals in result.PdfCompliance.
+	ComplianceCheck *bool `json:"compliance_check,omitempty" yaml:"compliance_check,omitempty"`
 }
 
+// DefaultColumnGapThreshold is the column gap threshold used when
+// PdfConfig.ColumnGapThreshold is unset.
+const DefaultColumnGapThreshold = 0.05
+
 // HierarchyConfig controls PDF hierarchy extraction based on font sizes.
 type HierarchyConfig struct {
 	// Enable hierarchy extraction. Default: true.
-	Enabled *bool `json:"enabled,omitempty"`
+	Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
 
 	// Number of font size clusters (2-10). Default: 6.
-	KClusters *int `json:"k_clusters,omitempty"`
+	KClusters *int `json:"k_clusters,omitempty" yaml:"k_clusters,omitempty"`
 
 	// Include bounding box information. Default: true.
-	IncludeBbox *bool `json:"include_bbox,omitempty"`
+	IncludeBbox *bool `json:"include_bbox,omitempty" yaml:"include_bbox,omitempty"`
 
 	// OCR coverage threshold (0.0-1.0). Default: null.
-	OcrCoverageThreshold *float64 `json:"ocr_coverage_threshold,omitempty"`
+	OcrCoverageThreshold *float64 `json:"ocr_coverage_threshold,omitempty" yaml:"ocr_coverage_threshold,omitempty"`
 }
 
 // TokenReductionConfig governs token pruning before embeddings.
 type TokenReductionConfig struct {
-	Mode                   string `json:"mode,omitempty"`
-	PreserveImportantWords *bool  `json:"preserve_important_words,omitempty"`
+	Mode                   string `json:"mode,omitempty" yaml:"mode,omitempty"`
+	PreserveImportantWords *bool  `json:"preserve_important_words,omitempty" yaml:"preserve_important_words,omitempty"`
 }
 
 // LanguageDetectionConfig enables automatic language detection.
 type LanguageDetectionConfig struct {
-	Enabled        *bool    `json:"enabled,omitempty"`
-	MinConfidence  *float64 `json:"min_confidence,omitempty"`
-	DetectMultiple *bool    `json:"detect_multiple,omitempty"`
+	Enabled        *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	MinConfidence  *float64 `json:"min_confidence,omitempty" yaml:"min_confidence,omitempty"`
+	DetectMultiple *bool    `json:"detect_multiple,omitempty" yaml:"detect_multiple,omitempty"`
+	KeepLanguages  []string `json:"keep_languages,omitempty" yaml:"keep_languages,omitempty"`
 }
 
 // PostProcessorConfig determines which post processors run.
 type PostProcessorConfig struct {
-	Enabled            *bool    `json:"enabled,omitempty"`
-	EnabledProcessors  []string `json:"enabled_processors,omitempty"`
-	DisabledProcessors []string `json:"disabled_processors,omitempty"`
+	Enabled            *bool    `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	EnabledProcessors  []string `json:"enabled_processors,omitempty" yaml:"enabled_processors,omitempty"`
+	DisabledProcessors []string `json:"disabled_processors,omitempty" yaml:"disabled_processors,omitempty"`
 }
 
 // EmbeddingModelType configures embedding model selection.
 type EmbeddingModelType struct {
-	Type       string `json:"type"`
-	Name       string `json:"name,omitempty"`
-	Model      string `json:"model,omitempty"`
-	ModelID    string `json:"model_id,omitempty"`
-	Dimensions *int   `json:"dimensions,omitempty"`
+	Type       string `json:"type" yaml:"type"`
+	Name       string `json:"name,omitempty" yaml:"name,omitempty"`
+	Model      string `json:"model,omitempty" yaml:"model,omitempty"`
+	ModelID    string `json:"model_id,omitempty" yaml:"model_id,omitempty"`
+	Dimensions *int   `json:"dimensions,omitempty" yaml:"dimensions,omitempty"`
 }
 
 // EmbeddingConfig configures embedding generation for chunks.
 type EmbeddingConfig struct {
-	Model                *EmbeddingModelType `json:"model,omitempty"`
-	Normalize            *bool               `json:"normalize,omitempty"`
-	BatchSize            *int                `json:"batch_size,omitempty"`
-	ShowDownloadProgress *bool               `json:"show_download_progress,omitempty"`
-	CacheDir             *string             `json:"cache_dir,omitempty"`
+	Model                *EmbeddingModelType `json:"model,omitempty" yaml:"model,omitempty"`
+	Normalize            *bool               `json:"normalize,omitempty" yaml:"normalize,omitempty"`
+	BatchSize            *int                `json:"batch_size,omitempty" yaml:"batch_size,omitempty"`
+	ShowDownloadProgress *bool               `json:"show_download_progress,omitempty" yaml:"show_download_progress,omitempty"`
+	CacheDir             *string             `json:"cache_dir,omitempty" yaml:"cache_dir,omitempty"`
+	// Concurrency is the number of batches the native core embeds in parallel.
+	// Default: 1.
+	Concurrency *int `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+	// MaxConcurrentRequests caps the in-flight requests to a remote embedding model,
+	// independently of Concurrency. Default: Concurrency.
+	MaxConcurrentRequests *int `json:"max_concurrent_requests,omitempty" yaml:"max_concurrent_requests,omitempty"`
+	// Cache asks the native core to reuse the vectors of chunks whose text was
+	// embedded before with the same model. Default: false.
+	Cache *bool `json:"cache,omitempty" yaml:"cache,omitempty"`
 }
 
 // KeywordConfig configures keyword extraction.
 type KeywordConfig struct {
-	Algorithm   string      `json:"algorithm,omitempty"`
-	MaxKeywords *int        `json:"max_keywords,omitempty"`
-	MinScore    *float64    `json:"min_score,omitempty"`
-	NgramRange  *[2]int     `json:"ngram_range,omitempty"`
-	Language    *string     `json:"language,omitempty"`
-	Yake        *YakeParams `json:"yake_params,omitempty"`
-	Rake        *RakeParams `json:"rake_params,omitempty"`
+	Algorithm   string      `json:"algorithm,omitempty" yaml:"algorithm,omitempty"`
+	MaxKeywords *int        `json:"max_keywords,omitempty" yaml:"max_keywords,omitempty"`
+	MinScore    *float64    `json:"min_score,omitempty" yaml:"min_score,omitempty"`
+	NgramRange  *[2]int     `json:"ngram_range,omitempty" yaml:"ngram_range,omitempty"`
+	Language    *string     `json:"language,omitempty" yaml:"language,omitempty"`
+	Yake        *YakeParams `json:"yake_params,omitempty" yaml:"yake_params,omitempty"`
+	Rake        *RakeParams `json:"rake_params,omitempty" yaml:"rake_params,omitempty"`
 }
 
 // YakeParams holds YAKE-specific tuning.
 type YakeParams struct {
-	WindowSize *int `json:"window_size,omitempty"`
+	WindowSize *int `json:"window_size,omitempty" yaml:"window_size,omitempty"`
 }
 
 // RakeParams holds RAKE-specific tuning.
 type RakeParams struct {
-	MinWordLength     *int `json:"min_word_length,omitempty"`
-	MaxWordsPerPhrase *int `json:"max_words_per_phrase,omitempty"`
+	MinWordLength     *int `json:"min_word_length,omitempty" yaml:"min_word_length,omitempty"`
+	MaxWordsPerPhrase *int `json:"max_words_per_phrase,omitempty" yaml:"max_words_per_phrase,omitempty"`
 }
 
 // HTMLPreprocessingOptions configures HTML cleaning.
 type HTMLPreprocessingOptions struct {
-	Enabled          *bool   `json:"enabled,omitempty"`
-	Preset           *string `json:"preset,omitempty"`
-	RemoveNavigation *bool   `json:"remove_navigation,omitempty"`
-	RemoveForms      *bool   `json:"remove_forms,omitempty"`
+	Enabled          *bool   `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+	Preset           *string `json:"preset,omitempty" yaml:"preset,omitempty"`
+	RemoveNavigation *bool   `json:"remove_navigation,omitempty" yaml:"remove_navigation,omitempty"`
+	RemoveForms      *bool   `json:"remove_forms,omitempty" yaml:"remove_forms,omitempty"`
 }
 
 // HTMLConversionOptions mirrors html_to_markdown_rs::ConversionOptions for HTML-to-Markdown conversion.
 type HTMLConversionOptions struct {
-	HeadingStyle       *string                   `json:"heading_style,omitempty"`
-	ListIndentType     *string                   `json:"list_indent_type,omitempty"`
-	ListIndentWidth    *int                      `json:"list_indent_width,omitempty"`
-	Bullets            *string                   `json:"bullets,omitempty"`
-	StrongEmSymbol     *string                   `json:"strong_em_symbol,omitempty"`
-	EscapeAsterisks    *bool                     `json:"escape_asterisks,omitempty"`
-	EscapeUnderscores  *bool                     `json:"escape_underscores,omitempty"`
-	EscapeMisc         *bool                     `json:"escape_misc,omitempty"`
-	EscapeASCII        *bool                     `json:"escape_ascii,omitempty"`
-	CodeLanguage       *string                   `json:"code_language,omitempty"`
-	Autolinks          *bool                     `json:"autolinks,omitempty"`
-	DefaultTitle       *bool                     `json:"default_title,omitempty"`
-	BrInTables         *bool                     `json:"br_in_tables,omitempty"`
-	HocrSpatialTables  *bool                     `json:"hocr_spatial_tables,omitempty"`
-	HighlightStyle     *string                   `json:"highlight_style,omitempty"`
-	ExtractMetadata    *bool                     `json:"extract_metadata,omitempty"`
-	WhitespaceMode     *string                   `json:"whitespace_mode,omitempty"`
-	StripNewlines      *bool                     `json:"strip_newlines,omitempty"`
-	Wrap               *bool                     `json:"wrap,omitempty"`
-	WrapWidth          *int                      `json:"wrap_width,omitempty"`
-	ConvertAsInline    *bool                     `json:"convert_as_inline,omitempty"`
-	SubSymbol          *string                   `json:"sub_symbol,omitempty"`
-	SupSymbol          *string                   `json:"sup_symbol,omitempty"`
-	NewlineStyle       *string                   `json:"newline_style,omitempty"`
-	CodeBlockStyle     *string                   `json:"code_block_style,omitempty"`
-	KeepInlineImagesIn []string                  `json:"keep_inline_images_in,omitempty"`
-	Encoding           *string                   `json:"encoding,omitempty"`
-	Debug              *bool                     `json:"debug,omitempty"`
-	StripTags          []string                  `json:"strip_tags,omitempty"`
-	PreserveTags       []string                  `json:"preserve_tags,omitempty"`
-	Preprocessing      *HTMLPreprocessingOptions `json:"preprocessing,omitempty"`
+	HeadingStyle       *string                   `json:"heading_style,omitempty" yaml:"heading_style,omitempty"`
+	ListIndentType     *string                   `json:"list_indent_type,omitempty" yaml:"list_indent_type,omitempty"`
+	ListIndentWidth    *int                      `json:"list_indent_width,omitempty" yaml:"list_indent_width,omitempty"`
+	Bullets            *string                   `json:"bullets,omitempty" yaml:"bullets,omitempty"`
+	StrongEmSymbol     *string                   `json:"strong_em_symbol,omitempty" yaml:"strong_em_symbol,omitempty"`
+	EscapeAsterisks    *bool                     `json:"escape_asterisks,omitempty" yaml:"escape_asterisks,omitempty"`
+	EscapeUnderscores  *bool                     `json:"escape_underscores,omitempty" yaml:"escape_underscores,omitempty"`
+	EscapeMisc         *bool                     `json:"escape_misc,omitempty" yaml:"escape_misc,omitempty"`
+	EscapeASCII        *bool                     `json:"escape_ascii,omitempty" yaml:"escape_ascii,omitempty"`
+	CodeLanguage       *string                   `json:"code_language,omitempty" yaml:"code_language,omitempty"`
+	Autolinks          *bool                     `json:"autolinks,omitempty" yaml:"autolinks,omitempty"`
+	DefaultTitle       *bool                     `json:"default_title,omitempty" yaml:"default_title,omitempty"`
+	BrInTables         *bool                     `json:"br_in_tables,omitempty" yaml:"br_in_tables,omitempty"`
+	HocrSpatialTables  *bool                     `json:"hocr_spatial_tables,omitempty" yaml:"hocr_spatial_tables,omitempty"`
+	HighlightStyle     *string                   `json:"highlight_style,omitempty" yaml:"highlight_style,omitempty"`
+	ExtractMetadata    *bool                     `json:"extract_metadata,omitempty" yaml:"extract_metadata,omitempty"`
+	WhitespaceMode     *string                   `json:"whitespace_mode,omitempty" yaml:"whitespace_mode,omitempty"`
+	StripNewlines      *bool                     `json:"strip_newlines,omitempty" yaml:"strip_newlines,omitempty"`
+	Wrap               *bool                     `json:"wrap,omitempty" yaml:"wrap,omitempty"`
+	WrapWidth          *int                      `json:"wrap_width,omitempty" yaml:"wrap_width,omitempty"`
+	ConvertAsInline    *bool                     `json:"convert_as_inline,omitempty" yaml:"convert_as_inline,omitempty"`
+	SubSymbol          *string                   `json:"sub_symbol,omitempty" yaml:"sub_symbol,omitempty"`
+	SupSymbol          *string                   `json:"sup_symbol,omitempty" yaml:"sup_symbol,omitempty"`
+	NewlineStyle       *string                   `json:"newline_style,omitempty" yaml:"newline_style,omitempty"`
+	CodeBlockStyle     *string                   `json:"code_block_style,omitempty" yaml:"code_block_style,omitempty"`
+	KeepInlineImagesIn []string                  `json:"keep_inline_images_in,omitempty" yaml:"keep_inline_images_in,omitempty"`
+	Encoding           *string                   `json:"encoding,omitempty" yaml:"encoding,omitempty"`
+	Debug              *bool                     `json:"debug,omitempty" yaml:"debug,omitempty"`
+	StripTags          []string                  `json:"strip_tags,omitempty" yaml:"strip_tags,omitempty"`
+	PreserveTags       []string                  `json:"preserve_tags,omitempty" yaml:"preserve_tags,omitempty"`
+	Preprocessing      *HTMLPreprocessingOptions `json:"preprocessing,omitempty" yaml:"preprocessing,omitempty"`
 }
 
 // PageConfig configures page tracking and extraction.
 type PageConfig struct {
-	ExtractPages      *bool   `json:"extract_pages,omitempty"`
-	InsertPageMarkers *bool   `json:"insert_page_markers,omitempty"`
-	MarkerFormat      *string `json:"marker_format,omitempty"`
+	ExtractPages      *bool   `json:"extract_pages,omitempty" yaml:"extract_pages,omitempty"`
+	InsertPageMarkers *bool   `json:"insert_page_markers,omitempty" yaml:"insert_page_markers,omitempty"`
+	MarkerFormat      *string `json:"marker_format,omitempty" yaml:"marker_format,omitempty"`
+	// PageNumbers restricts the result to the listed 1-indexed pages. The list is
+	// forwarded to the native core, and pages it returns outside the list are
+	// dropped on the Go side. Default: all pages.
+	PageNumbers []uint64 `json:"page_numbers,omitempty" yaml:"page_numbers,omitempty"`
+	// ThumbnailDPI asks the native core for a thumbnail of every page at this
+	// resolution in PageContent.Thumbnail. Default: no thumbnails.
+	ThumbnailDPI *int `json:"thumbnail_dpi,omitempty" yaml:"thumbnail_dpi,omitempty"`
+}
+
+// SpreadsheetConfig exposes spreadsheet-specific (XLSX/XLS/ODS) options. Only
+// FormulaMode is validated on the Go side; the config is otherwise forwarded to
+// the native core unchanged.
+type SpreadsheetConfig struct {
+	// FormulaMode selects what formula cells carry: "value", "formula", or "both".
+	// With "both", the core reports formulas in Table.Formulas. Default: "value".
+	FormulaMode string `json:"formula_mode,omitempty" yaml:"formula_mode,omitempty"`
+}
+
+// Spreadsheet formula modes accepted by SpreadsheetConfig.FormulaMode.
+const (
+	SpreadsheetFormulaModeValue   = "value"
+	SpreadsheetFormulaModeFormula = "formula"
+	SpreadsheetFormulaModeBoth    = "both"
+)
+
+// PresentationConfig exposes presentation-specific (PPTX/PPT/ODP) options. It is
+// forwarded to the native core as-is and has no effect on other formats.
+type PresentationConfig struct {
+	// IncludeSpeakerNotes asks for speaker notes attached to each slide's page. Default: false.
+	IncludeSpeakerNotes *bool `json:"include_speaker_notes,omitempty" yaml:"include_speaker_notes,omitempty"`
+
+	// SlidePerPage maps each slide to its own page and element. Default: false.
+	SlidePerPage *bool `json:"slide_per_page,omitempty" yaml:"slide_per_page,omitempty"`
+}
+
+// DocxConfig exposes Word document (DOCX) options. It is forwarded to the native
+// core unchanged.
+type DocxConfig struct {
+	// RevisionHistory asks for every tracked change in result.Revisions. It does
+	// not accept or reject the changes. Default: false.
+	RevisionHistory *bool `json:"revision_history,omitempty" yaml:"revision_history,omitempty"`
 }
 
 // OutputFormat controls the format of extracted content.
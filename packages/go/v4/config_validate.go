@@ -0,0 +1,463 @@
+package kreuzberg
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// ConfigFieldError describes one invalid field found by ExtractionConfig.Validate.
+type ConfigFieldError struct {
+	// Path is the Go field path of the invalid value, e.g. "OCR.Tesseract.PSM".
+	// It is empty when the error concerns the validated config as a whole.
+	Path string
+	// Err is the validation failure for the field.
+	Err error
+}
+
+func (e *ConfigFieldError) Error() string {
+	message := strings.TrimPrefix(e.Err.Error(), "kreuzberg: ")
+	if e.Path == "" {
+		return message
+	}
+	return e.Path + ": " + message
+}
+
+func (e *ConfigFieldError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks every set field of the config tree that has a known valid
+// range or value set, so misconfiguration can be caught at startup rather than
+// on the first extraction. Nil sub-configs and unset fields are skipped.
+//
+// All invalid fields are reported together in one *ValidationError, one line
+// per field:
+//
+//	kreuzberg: invalid config (2 errors): OCR.Tesseract.PSM: invalid Tesseract PSM value: 14 (valid range: 0-13)
+//	Chunking: invalid max_chars: 0 (must be > 0)
+//
+// Each field is available as a *ConfigFieldError through errors.As, or all of
+// them by unwrapping the error's cause with Unwrap() []error. Checks that are
+// implemented natively, such as OCR backend names, require the native library.
+func (c *ExtractionConfig) Validate() error {
+	v := &configValidator{}
+	v.extraction(c)
+	return v.err()
+}
+
+// Validate checks the OCR config like ExtractionConfig.Validate, with field
+// paths relative to the OCRConfig.
+func (c *OCRConfig) Validate() error {
+	v := &configValidator{}
+	v.ocr("", c)
+	return v.err()
+}
+
+// Validate checks the Tesseract config like ExtractionConfig.Validate, with
+// field paths relative to the TesseractConfig.
+func (c *TesseractConfig) Validate() error {
+	v := &configValidator{}
+	v.tesseract("", c)
+	return v.err()
+}
+
+// Validate checks the PaddleOCR config like ExtractionConfig.Validate, with
+// field paths relative to the PaddleConfig.
+func (c *PaddleConfig) Validate() error {
+	v := &configValidator{}
+	v.paddle("", c)
+	return v.err()
+}
+
+// Validate checks the EasyOCR config like ExtractionConfig.Validate, with field
+// paths relative to the EasyOCRConfig.
+func (c *EasyOCRConfig) Validate() error {
+	v := &configValidator{}
+	v.easyOCR("", c)
+	return v.err()
+}
+
+// Validate checks the preprocessing config like ExtractionConfig.Validate, with
+// field paths relative to the ImagePreprocessingConfig.
+func (c *ImagePreprocessingConfig) Validate() error {
+	v := &configValidator{}
+	v.preprocessing("", c)
+	return v.err()
+}
+
+// Validate checks the chunking config like ExtractionConfig.Validate.
+func (c *ChunkingConfig) Validate() error {
+	v := &configValidator{}
+	v.chunking("", c)
+	return v.err()
+}
+
+// Validate checks the image extraction config like ExtractionConfig.Validate,
+// with field paths relative to the ImageExtractionConfig.
+func (c *ImageExtractionConfig) Validate() error {
+	v := &configValidator{}
+	v.images("", c)
+	return v.err()
+}
+
+// Validate checks the PDF config like ExtractionConfig.Validate, with field
+// paths relative to the PdfConfig.
+func (c *PdfConfig) Validate() error {
+	v := &configValidator{}
+	v.pdf("", c)
+	return v.err()
+}
+
+// Validate checks the token reduction config like ExtractionConfig.Validate,
+// with field paths relative to the TokenReductionConfig.
+func (c *TokenReductionConfig) Validate() error {
+	v := &configValidator{}
+	v.tokenReduction("", c)
+	return v.err()
+}
+
+// Validate checks the language detection config like ExtractionConfig.Validate,
+// with field paths relative to the LanguageDetectionConfig.
+func (c *LanguageDetectionConfig) Validate() error {
+	v := &configValidator{}
+	v.languageDetection("", c)
+	return v.err()
+}
+
+// Validate checks the keyword config like ExtractionConfig.Validate, with field
+// paths relative to the KeywordConfig.
+func (c *KeywordConfig) Validate() error {
+	v := &configValidator{}
+	v.keywords("", c)
+	return v.err()
+}
+
+// Validate checks the page config like ExtractionConfig.Validate, with field
+// paths relative to the PageConfig.
+func (c *PageConfig) Validate() error {
+	v := &configValidator{}
+	v.pages("", c)
+	return v.err()
+}
+
+// Validate checks the spreadsheet config like ExtractionConfig.Validate, with
+// field paths relative to the SpreadsheetConfig.
+func (c *SpreadsheetConfig) Validate() error {
+	v := &configValidator{}
+	v.spreadsheet("", c)
+	return v.err()
+}
+
+// configValidator collects field errors in the order fields are checked.
+type configValidator struct {
+	errs []error
+}
+
+func (v *configValidator) check(path string, err error) {
+	if err != nil {
+		v.errs = append(v.errs, &ConfigFieldError{Path: path, Err: err})
+	}
+}
+
+func (v *configValidator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	message := "invalid config (1 error)"
+	if len(v.errs) > 1 {
+		message = fmt.Sprintf("invalid config (%d errors)", len(v.errs))
+	}
+	return newValidationErrorWithContext(message, errors.Join(v.errs...), ErrorCodeValidation, nil)
+}
+
+// fieldPath joins a parent path and a field name.
+func fieldPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func (v *configValidator) extraction(c *ExtractionConfig) {
+	if c == nil {
+		return
+	}
+	if c.OutputFormat != "" {
+		v.check("OutputFormat", ValidateContentFormat(c.OutputFormat))
+	}
+	if c.ResultFormat != "" {
+		switch ResultFormat(c.ResultFormat) {
+		case ResultFormatUnified, ResultFormatElementBased:
+		default:
+			v.check("ResultFormat", newValidationErrorWithContext(fmt.Sprintf("invalid result format: %s (valid: unified, element_based)", c.ResultFormat), nil, ErrorCodeValidation, nil))
+		}
+	}
+	if c.InlineLinkStyle != "" {
+		v.check("InlineLinkStyle", ValidateInlineLinkStyle(c.InlineLinkStyle))
+	}
+	if c.HyphenationLanguage != "" {
+		v.check("HyphenationLanguage", ValidateHyphenationLanguage(c.HyphenationLanguage))
+	}
+	if c.RubyHandling != "" {
+		v.check("RubyHandling", ValidateRubyHandling(c.RubyHandling))
+	}
+	if c.NormalizeNumbers != "" {
+		v.check("NormalizeNumbers", ValidateNumberLocale(c.NormalizeNumbers))
+	}
+	if c.CacheKeyStrategy != "" {
+		v.check("CacheKeyStrategy", ValidateCacheKeyStrategy(c.CacheKeyStrategy))
+	}
+	if c.CacheDir != nil && *c.CacheDir == "" {
+		v.check("CacheDir", newValidationErrorWithContext("cache directory cannot be empty", nil, ErrorCodeValidation, nil))
+	}
+	if c.MaxConcurrentExtractions != nil && *c.MaxConcurrentExtractions < 0 {
+		v.check("MaxConcurrentExtractions", newValidationErrorWithContext(fmt.Sprintf("invalid max concurrent extractions: %d (must be >= 0)", *c.MaxConcurrentExtractions), nil, ErrorCodeValidation, nil))
+	}
+	if c.MaxTablesPerPage != nil && *c.MaxTablesPerPage <= 0 {
+		v.check("MaxTablesPerPage", newValidationErrorWithContext(fmt.Sprintf("invalid max tables per page: %d (must be > 0)", *c.MaxTablesPerPage), nil, ErrorCodeValidation, nil))
+	}
+	if c.MaxContentBytes != nil && *c.MaxContentBytes <= 0 {
+		v.check("MaxContentBytes", newValidationErrorWithContext(fmt.Sprintf("invalid max content bytes: %d (must be > 0)", *c.MaxContentBytes), nil, ErrorCodeValidation, nil))
+	}
+	if c.MaxReaderSize != nil && *c.MaxReaderSize <= 0 {
+		v.check("MaxReaderSize", newValidationErrorWithContext(fmt.Sprintf("invalid max reader size: %d (must be > 0)", *c.MaxReaderSize), nil, ErrorCodeValidation, nil))
+	}
+
+	v.ocr("OCR", c.OCR)
+	v.chunking("Chunking", c.Chunking)
+	v.images("Images", c.Images)
+	v.pdf("PdfOptions", c.PdfOptions)
+	v.tokenReduction("TokenReduction", c.TokenReduction)
+	v.languageDetection("LanguageDetection", c.LanguageDetection)
+	v.keywords("Keywords", c.Keywords)
+	v.pages("Pages", c.Pages)
+	v.spreadsheet("Spreadsheet", c.Spreadsheet)
+}
+
+func (v *configValidator) ocr(path string, c *OCRConfig) {
+	if c == nil {
+		return
+	}
+	if c.Backend != "" {
+		v.check(fieldPath(path, "Backend"), validateOCRBackendName(c.Backend))
+	}
+	if c.Language != nil {
+		v.check(fieldPath(path, "Language"), validateOCRLanguage(*c.Language))
+	}
+	for i, backend := range c.AdditionalBackends {
+		v.check(fmt.Sprintf("%s[%d]", fieldPath(path, "AdditionalBackends"), i), validateOCRBackendName(backend))
+	}
+	if c.MergeStrategy != "" {
+		v.check(fieldPath(path, "MergeStrategy"), ValidateOCRMergeStrategy(c.MergeStrategy))
+	}
+	if c.MinTextLengthPerPage != nil && *c.MinTextLengthPerPage < 0 {
+		v.check(fieldPath(path, "MinTextLengthPerPage"), newValidationErrorWithContext(fmt.Sprintf("invalid min text length: %d (must be >= 0)", *c.MinTextLengthPerPage), nil, ErrorCodeValidation, nil))
+	}
+	v.tesseract(fieldPath(path, "Tesseract"), c.Tesseract)
+	v.paddle(fieldPath(path, "Paddle"), c.Paddle)
+	v.easyOCR(fieldPath(path, "EasyOCR"), c.EasyOCR)
+}
+
+// validateOCRBackendName accepts the backends known to ValidateOCRBackend and
+// the names of OCR backends registered with RegisterOCRBackend, which
+// ValidateOCRBackend does not know about.
+func validateOCRBackendName(backend string) error {
+	err := ValidateOCRBackend(backend)
+	if err == nil {
+		return nil
+	}
+	if registered, listErr := ListOCRBackends(); listErr == nil && slices.Contains(registered, backend) {
+		return nil
+	}
+	return err
+}
+
+// validateOCRLanguage checks each code of a Tesseract language string such as
+// "eng+deu" with ValidateLanguageCode.
+func validateOCRLanguage(language string) error {
+	if language == "" {
+		return newValidationErrorWithContext("at least one OCR language is required", nil, ErrorCodeValidation, nil)
+	}
+	for _, code := range strings.Split(language, "+") {
+		if err := ValidateLanguageCode(code); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *configValidator) tesseract(path string, c *TesseractConfig) {
+	if c == nil {
+		return
+	}
+	if c.PSM != nil {
+		v.check(fieldPath(path, "PSM"), ValidateTesseractPSM(*c.PSM))
+	}
+	if c.OEM != nil {
+		v.check(fieldPath(path, "OEM"), ValidateTesseractOEM(*c.OEM))
+	}
+	if c.OutputFormat != "" {
+		v.check(fieldPath(path, "OutputFormat"), ValidateOutputFormat(c.OutputFormat))
+	}
+	if c.MinConfidence != nil {
+		v.check(fieldPath(path, "MinConfidence"), ValidateConfidence(*c.MinConfidence))
+	}
+	if c.TableMinConfidence != nil {
+		v.check(fieldPath(path, "TableMinConfidence"), ValidateConfidence(*c.TableMinConfidence))
+	}
+	if c.PSM != nil && !tesseractPSMRecognizes(*c.PSM) {
+		if c.OEM != nil {
+			v.check(fieldPath(path, "OEM"), newValidationErrorWithContext(fmt.Sprintf("Tesseract OEM %d has no effect with PSM %d, which does not run text recognition", *c.OEM, *c.PSM), nil, ErrorCodeValidation, nil))
+		}
+		if c.EnableTableDetection != nil && *c.EnableTableDetection {
+			v.check(fieldPath(path, "EnableTableDetection"), newValidationErrorWithContext(fmt.Sprintf("table detection requires text recognition, which Tesseract PSM %d does not run", *c.PSM), nil, ErrorCodeValidation, nil))
+		}
+	}
+	if conflict := charListConflict(c.TesseditCharWhitelist, c.TesseditCharBlacklist); conflict != "" {
+		v.check(fieldPath(path, "TesseditCharBlacklist"), newValidationErrorWithContext(fmt.Sprintf("characters %q are both whitelisted and blacklisted", conflict), nil, ErrorCodeValidation, nil))
+	}
+	v.preprocessing(fieldPath(path, "Preprocessing"), c.Preprocessing)
+}
+
+// tesseractPSMRecognizes reports whether a Tesseract page segmentation mode runs
+// text recognition. PSM 0 only detects orientation and script, and PSM 2 only
+// segments the page.
+func tesseractPSMRecognizes(psm int) bool {
+	return psm != 0 && psm != 2
+}
+
+// charListConflict returns the characters of whitelist that are also in
+// blacklist, in whitelist order and without duplicates.
+func charListConflict(whitelist, blacklist string) string {
+	if whitelist == "" || blacklist == "" {
+		return ""
+	}
+	var conflict []rune
+	for _, r := range whitelist {
+		if strings.ContainsRune(blacklist, r) && !slices.Contains(conflict, r) {
+			conflict = append(conflict, r)
+		}
+	}
+	return string(conflict)
+}
+
+func (v *configValidator) paddle(path string, c *PaddleConfig) {
+	if c == nil {
+		return
+	}
+	dirs := []struct {
+		name string
+		dir  *string
+	}{{"DetModelDir", c.DetModelDir}, {"RecModelDir", c.RecModelDir}, {"ClsModelDir", c.ClsModelDir}}
+	for _, d := range dirs {
+		if d.dir != nil && *d.dir == "" {
+			v.check(fieldPath(path, d.name), newValidationErrorWithContext("model directory cannot be empty", nil, ErrorCodeValidation, nil))
+		}
+	}
+	if c.MinConfidence != nil {
+		v.check(fieldPath(path, "MinConfidence"), ValidateConfidence(*c.MinConfidence))
+	}
+}
+
+func (v *configValidator) easyOCR(path string, c *EasyOCRConfig) {
+	if c == nil {
+		return
+	}
+	for i, lang := range c.Languages {
+		v.check(fmt.Sprintf("%s[%d]", fieldPath(path, "Languages"), i), ValidateLanguageCode(lang))
+	}
+	if c.Device != nil && *c.Device == "" {
+		v.check(fieldPath(path, "Device"), newValidationErrorWithContext("device cannot be empty", nil, ErrorCodeValidation, nil))
+	}
+	if c.BatchSize != nil && *c.BatchSize <= 0 {
+		v.check(fieldPath(path, "BatchSize"), newValidationErrorWithContext(fmt.Sprintf("invalid batch size: %d (must be > 0)", *c.BatchSize), nil, ErrorCodeValidation, nil))
+	}
+}
+
+func (v *configValidator) preprocessing(path string, c *ImagePreprocessingConfig) {
+	if c == nil {
+		return
+	}
+	if c.TargetDPI != nil {
+		v.check(fieldPath(path, "TargetDPI"), ValidateDPI(*c.TargetDPI))
+	}
+	if c.BinarizationMode != "" {
+		v.check(fieldPath(path, "BinarizationMode"), ValidateBinarizationMethod(c.BinarizationMode))
+	}
+}
+
+func (v *configValidator) chunking(path string, c *ChunkingConfig) {
+	if c != nil {
+		v.check(path, validateChunkingConfig(c))
+	}
+}
+
+func (v *configValidator) images(path string, c *ImageExtractionConfig) {
+	if c == nil {
+		return
+	}
+	if c.TargetDPI != nil {
+		v.check(fieldPath(path, "TargetDPI"), ValidateDPI(*c.TargetDPI))
+	}
+	if c.MinDPI != nil {
+		v.check(fieldPath(path, "MinDPI"), ValidateDPI(*c.MinDPI))
+	}
+	if c.MaxDPI != nil {
+		v.check(fieldPath(path, "MaxDPI"), ValidateDPI(*c.MaxDPI))
+	}
+	if c.MinDPI != nil && c.MaxDPI != nil && *c.MinDPI > *c.MaxDPI {
+		v.check(fieldPath(path, "MinDPI"), newValidationErrorWithContext(fmt.Sprintf("min DPI %d exceeds max DPI %d", *c.MinDPI, *c.MaxDPI), nil, ErrorCodeValidation, nil))
+	}
+}
+
+func (v *configValidator) pdf(path string, c *PdfConfig) {
+	if c != nil && c.ColumnGapThreshold != nil {
+		v.check(fieldPath(path, "ColumnGapThreshold"), ValidateColumnGapThreshold(*c.ColumnGapThreshold))
+	}
+}
+
+func (v *configValidator) tokenReduction(path string, c *TokenReductionConfig) {
+	if c != nil && c.Mode != "" {
+		v.check(fieldPath(path, "Mode"), ValidateTokenReductionLevel(c.Mode))
+	}
+}
+
+func (v *configValidator) languageDetection(path string, c *LanguageDetectionConfig) {
+	if c != nil && c.MinConfidence != nil {
+		v.check(fieldPath(path, "MinConfidence"), ValidateConfidence(*c.MinConfidence))
+	}
+}
+
+func (v *configValidator) keywords(path string, c *KeywordConfig) {
+	if c == nil {
+		return
+	}
+	if c.MaxKeywords != nil && *c.MaxKeywords < 0 {
+		v.check(fieldPath(path, "MaxKeywords"), newValidationErrorWithContext(fmt.Sprintf("invalid max keywords: %d (must be >= 0)", *c.MaxKeywords), nil, ErrorCodeValidation, nil))
+	}
+	if c.NgramRange != nil && (c.NgramRange[0] < 1 || c.NgramRange[0] > c.NgramRange[1]) {
+		v.check(fieldPath(path, "NgramRange"), newValidationErrorWithContext(fmt.Sprintf("invalid n-gram range: %v (must satisfy 1 <= min <= max)", *c.NgramRange), nil, ErrorCodeValidation, nil))
+	}
+}
+
+func (v *configValidator) pages(path string, c *PageConfig) {
+	if c == nil {
+		return
+	}
+	for i, page := range c.PageNumbers {
+		if page == 0 {
+			v.check(fmt.Sprintf("%s[%d]", fieldPath(path, "PageNumbers"), i), newValidationErrorWithContext("invalid page number: 0 (pages are 1-indexed)", nil, ErrorCodeValidation, nil))
+		}
+	}
+	if c.ThumbnailDPI != nil {
+		v.check(fieldPath(path, "ThumbnailDPI"), ValidateDPI(*c.ThumbnailDPI))
+	}
+}
+
+func (v *configValidator) spreadsheet(path string, c *SpreadsheetConfig) {
+	if c != nil && c.FormulaMode != "" {
+		v.check(fieldPath(path, "FormulaMode"), ValidateSpreadsheetFormulaMode(c.FormulaMode))
+	}
+}
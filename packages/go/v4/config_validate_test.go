@@ -0,0 +1,163 @@
+package kreuzberg
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExtractionConfigValidate_Valid(t *testing.T) {
+	var nilConfig *ExtractionConfig
+	if err := nilConfig.Validate(); err != nil {
+		t.Errorf("expected nil config to be valid, got %v", err)
+	}
+	if err := NewExtractionConfig().Validate(); err != nil {
+		t.Errorf("expected empty config to be valid, got %v", err)
+	}
+
+	config := &ExtractionConfig{
+		OutputFormat: string(OutputFormatMarkdown),
+		Chunking:     &ChunkingConfig{MaxChars: IntPtr(1000)},
+		Pages:        &PageConfig{PageNumbers: []uint64{1, 3}},
+		Spreadsheet:  &SpreadsheetConfig{FormulaMode: SpreadsheetFormulaModeBoth},
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("expected config to be valid, got %v", err)
+	}
+}
+
+func TestExtractionConfigValidate_CollectsAllErrors(t *testing.T) {
+	config := &ExtractionConfig{
+		OutputFormat: "pdf",
+		Chunking:     &ChunkingConfig{MaxChars: IntPtr(0)},
+		Pages:        &PageConfig{PageNumbers: []uint64{2, 0}},
+		Spreadsheet:  &SpreadsheetConfig{FormulaMode: "x"},
+		PdfOptions:   &PdfConfig{ColumnGapThreshold: FloatPtr(2)},
+	}
+
+	err := config.Validate()
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("expected ValidationError, got %T: %v", err, err)
+	}
+	if !strings.Contains(err.Error(), "5 errors") {
+		t.Errorf("expected error count in message, got %q", err.Error())
+	}
+	for _, path := range []string{"OutputFormat", "Chunking", "Pages.PageNumbers[1]", "Spreadsheet.FormulaMode", "PdfOptions.ColumnGapThreshold"} {
+		if !strings.Contains(err.Error(), path+": ") {
+			t.Errorf("expected %s in message, got %q", path, err.Error())
+		}
+	}
+
+	var fieldErr *ConfigFieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "OutputFormat" {
+		t.Errorf("expected first ConfigFieldError for OutputFormat, got %+v", fieldErr)
+	}
+
+	joined, ok := errors.Unwrap(err).(interface{ Unwrap() []error })
+	if !ok {
+		t.Fatalf("expected cause to unwrap to a list of errors, got %T", errors.Unwrap(err))
+	}
+	if got := len(joined.Unwrap()); got != 5 {
+		t.Errorf("expected 5 field errors, got %d", got)
+	}
+}
+
+func TestValidateTesseractConfig(t *testing.T) {
+	if err := ValidateTesseractConfig(nil); err != nil {
+		t.Errorf("expected nil config to be valid, got %v", err)
+	}
+	valid := &TesseractConfig{PSM: IntPtr(6), OEM: IntPtr(1), TesseditCharWhitelist: "0123456789", TesseditCharBlacklist: "Il"}
+	if err := ValidateTesseractConfig(valid); err != nil {
+		t.Errorf("expected config to be valid, got %v", err)
+	}
+
+	invalid := &TesseractConfig{
+		PSM:                   IntPtr(0),
+		OEM:                   IntPtr(1),
+		EnableTableDetection:  BoolPtr(true),
+		TesseditCharWhitelist: "0123456789OI",
+		TesseditCharBlacklist: "OIlO",
+	}
+	err := ValidateTesseractConfig(invalid)
+	if err == nil {
+		t.Fatal("expected error for conflicting Tesseract settings")
+	}
+	for _, want := range []string{"3 errors", "OEM: ", "EnableTableDetection: ", `TesseditCharBlacklist: characters "OI"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("expected %q in message, got %q", want, err.Error())
+		}
+	}
+}
+
+func TestTesseractCharBlacklist(t *testing.T) {
+	cfg, err := NewTesseractConfigChecked(WithTesseractTesseditCharBlacklist("|¦"))
+	if err != nil {
+		t.Fatalf("expected blacklist alone to be valid, got %v", err)
+	}
+	if cfg.TesseditCharBlacklist != "|¦" {
+		t.Errorf("expected blacklist to be set, got %q", cfg.TesseditCharBlacklist)
+	}
+
+	config := NewExtractionConfig(WithOCR(WithTesseract(
+		WithTesseractTesseditCharWhitelist("0123456789|"),
+		WithTesseractTesseditCharBlacklist("|¦"),
+	)))
+	if err := validateConfigBeforeFFI(config); err == nil || !strings.Contains(err.Error(), `"|"`) {
+		t.Errorf("expected overlap to be rejected before extraction, got %v", err)
+	}
+}
+
+func TestWithOCRLanguages(t *testing.T) {
+	ocr := NewOCRConfig(WithOCRLanguages("eng", "deu"))
+	if ocr.Language == nil || *ocr.Language != "eng+deu" {
+		t.Fatalf("expected joined languages, got %v", ocr.Language)
+	}
+	if err := NewExtractionConfig(WithOCR(WithOCRLanguages("eng", "deu"))).Validate(); err != nil {
+		t.Errorf("expected valid config, got %v", err)
+	}
+
+	for _, langs := range [][]string{nil, {"eng", ""}, {"eng", "not-a-language"}} {
+		config := NewExtractionConfig(WithOCR(WithOCRLanguages(langs...)))
+		err := config.Validate()
+		var fieldErr *ConfigFieldError
+		if !errors.As(err, &fieldErr) || fieldErr.Path != "OCR.Language" {
+			t.Errorf("WithOCRLanguages(%q): expected OCR.Language error, got %v", langs, err)
+		}
+		if err := cloneConfig(config).Validate(); err == nil {
+			t.Errorf("WithOCRLanguages(%q): expected error to survive cloning", langs)
+		}
+	}
+
+	config := NewExtractionConfig(WithOCR(WithOCRLanguages("eng", "not-a-language"), WithOCRLanguage("eng")))
+	if err := config.Validate(); err != nil {
+		t.Errorf("expected a later WithOCRLanguage to replace the invalid languages, got %v", err)
+	}
+}
+
+func TestNewConfigChecked(t *testing.T) {
+	config, err := NewExtractionConfigChecked(WithOutputFormat("markdown"), WithPages(WithPageNumbers(1, 2)))
+	if err != nil || config == nil {
+		t.Fatalf("expected valid config, got %v, %v", config, err)
+	}
+
+	if _, err := NewExtractionConfigChecked(WithPages(WithPageNumbers(0))); err == nil || !strings.Contains(err.Error(), "Pages.PageNumbers[0]") {
+		t.Errorf("expected page number error, got %v", err)
+	}
+
+	pdf, err := NewPdfConfigChecked(WithColumnGapThreshold(2))
+	if pdf != nil {
+		t.Error("expected no config when validation fails")
+	}
+	var fieldErr *ConfigFieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "ColumnGapThreshold" {
+		t.Errorf("expected relative field path, got %v", err)
+	}
+
+	if _, err := NewChunkingConfigChecked(WithMaxChars(0)); err == nil || strings.Contains(err.Error(), ": : ") {
+		t.Errorf("expected chunking error without an empty path, got %v", err)
+	}
+	if _, err := NewSpreadsheetConfigChecked(); err != nil {
+		t.Errorf("expected default spreadsheet config to be valid, got %v", err)
+	}
+}
@@ -0,0 +1,237 @@
+package kreuzberg
+
+import (
+	"strings"
+	"unicode"
+)
+
+// script is a writing system whose letters have look-alikes in another script.
+type script int
+
+const (
+	scriptNone script = iota
+	scriptLatin
+	scriptCyrillic
+	scriptGreek
+)
+
+// confusableScripts lists the scripts handled by normalizeConfusables.
+var confusableScripts = []script{scriptLatin, scriptCyrillic, scriptGreek}
+
+// cyrillicLatin and greekLatin map Cyrillic and Greek letters to the Latin letters
+// they are rendered identically to in common fonts.
+var (
+	cyrillicLatin = map[rune]rune{
+		'а': 'a', 'с': 'c', 'ԁ': 'd', 'е': 'e', 'һ': 'h', 'і': 'i', 'ј': 'j', 'ӏ': 'l',
+		'о': 'o', 'р': 'p', 'ԛ': 'q', 'ѕ': 's', 'ԝ': 'w', 'х': 'x', 'у': 'y',
+		'А': 'A', 'В': 'B', 'С': 'C', 'Е': 'E', 'Н': 'H', 'І': 'I', 'Ј': 'J', 'К': 'K',
+		'М': 'M', 'О': 'O', 'Р': 'P', 'Ѕ': 'S', 'Т': 'T', 'Х': 'X', 'У': 'Y',
+	}
+	greekLatin = map[rune]rune{
+		'ο': 'o', 'ν': 'v',
+		'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'I', 'Κ': 'K', 'Μ': 'M',
+		'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	}
+)
+
+// confusables maps each pair of scripts to the letters of the first that have a
+// look-alike in the second.
+var confusables = func() map[[2]script]map[rune]rune {
+	tables := map[[2]script]map[rune]rune{
+		{scriptCyrillic, scriptLatin}: cyrillicLatin,
+		{scriptGreek, scriptLatin}:    greekLatin,
+		{scriptLatin, scriptCyrillic}: invertRunes(cyrillicLatin),
+		{scriptLatin, scriptGreek}:    invertRunes(greekLatin),
+	}
+	cyrillicGreek := map[rune]rune{}
+	for c, l := range cyrillicLatin {
+		if g, ok := tables[[2]script{scriptLatin, scriptGreek}][l]; ok {
+			cyrillicGreek[c] = g
+		}
+	}
+	tables[[2]script{scriptCyrillic, scriptGreek}] = cyrillicGreek
+	tables[[2]script{scriptGreek, scriptCyrillic}] = invertRunes(cyrillicGreek)
+	return tables
+}()
+
+// invertRunes returns the inverse of m.
+func invertRunes(m map[rune]rune) map[rune]rune {
+	out := make(map[rune]rune, len(m))
+	for k, v := range m {
+		out[v] = k
+	}
+	return out
+}
+
+// scriptOf returns the script of r, or scriptNone for other runes.
+func scriptOf(r rune) script {
+	switch {
+	case unicode.Is(unicode.Latin, r):
+		return scriptLatin
+	case unicode.Is(unicode.Cyrillic, r):
+		return scriptCyrillic
+	case unicode.Is(unicode.Greek, r):
+		return scriptGreek
+	}
+	return scriptNone
+}
+
+// isConfusable reports whether r, of script s, has a look-alike in another script.
+func isConfusable(r rune, s script) bool {
+	for _, other := range confusableScripts {
+		if other == s {
+			continue
+		}
+		if _, ok := confusables[[2]script{s, other}][r]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// scriptCounts counts the letters of a text per script.
+type scriptCounts [scriptGreek + 1]int
+
+// dominant returns the script with the most letters, or scriptNone on a tie or
+// when there are none.
+func (c scriptCounts) dominant() script {
+	best, bestCount, tie := scriptNone, 0, false
+	for _, s := range confusableScripts {
+		switch {
+		case c[s] > bestCount:
+			best, bestCount, tie = s, c[s], false
+		case c[s] == bestCount && bestCount > 0:
+			tie = true
+		}
+	}
+	if tie {
+		return scriptNone
+	}
+	return best
+}
+
+// normalizeConfusables replaces the look-alike letters of words that mix Latin,
+// Cyrillic, and Greek letters, such as a Cyrillic "е" in "Thе", by the letters of
+// the word's script, and returns the text and the number of letters replaced.
+// The script of a word is the script of most of its letters that have no
+// look-alike; when the word has none, the script of most letters on its line is
+// used. Words written in a single script are never changed, so genuinely
+// multilingual text is preserved.
+func normalizeConfusables(text string) (string, int) {
+	var b strings.Builder
+	total := 0
+	changed := false
+	for _, line := range strings.SplitAfter(text, "\n") {
+		normalized, n := normalizeConfusablesLine(line)
+		total += n
+		changed = changed || n > 0
+		b.WriteString(normalized)
+	}
+	if !changed {
+		return text, 0
+	}
+	return b.String(), total
+}
+
+// normalizeConfusablesLine normalizes the words of a single line.
+func normalizeConfusablesLine(line string) (string, int) {
+	var lineCounts scriptCounts
+	for _, r := range line {
+		lineCounts[scriptOf(r)]++
+	}
+	if countScripts(lineCounts) < 2 {
+		return line, 0
+	}
+
+	runes := []rune(line)
+	total := 0
+	for start := 0; start < len(runes); {
+		if !unicode.IsLetter(runes[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsMark(runes[end])) {
+			end++
+		}
+		total += normalizeConfusableWord(runes[start:end], lineCounts.dominant())
+		start = end
+	}
+	if total == 0 {
+		return line, 0
+	}
+	return string(runes), total
+}
+
+// normalizeConfusableWord rewrites the look-alike letters of a mixed-script word
+// in place, falling back to lineScript for words without unambiguous letters,
+// and returns the number of letters replaced.
+func normalizeConfusableWord(word []rune, lineScript script) int {
+	var letters, unambiguous scriptCounts
+	for _, r := range word {
+		s := scriptOf(r)
+		letters[s]++
+		if s != scriptNone && !isConfusable(r, s) {
+			unambiguous[s]++
+		}
+	}
+	if countScripts(letters) < 2 {
+		return 0
+	}
+
+	target := unambiguous.dominant()
+	if target == scriptNone && countScripts(unambiguous) == 0 {
+		target = lineScript
+	}
+	if target == scriptNone {
+		return 0
+	}
+
+	replaced := 0
+	for i, r := range word {
+		s := scriptOf(r)
+		if s == scriptNone || s == target {
+			continue
+		}
+		if canonical, ok := confusables[[2]script{s, target}][r]; ok {
+			word[i] = canonical
+			replaced++
+		}
+	}
+	return replaced
+}
+
+// countScripts returns the number of confusable scripts with at least one letter.
+func countScripts(c scriptCounts) int {
+	n := 0
+	for _, s := range confusableScripts {
+		if c[s] > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// normalizeResultConfusables normalizes the look-alike letters in the result's
+// content, page texts, and tables, and records the number replaced in Content.
+func normalizeResultConfusables(result *ExtractionResult) {
+	result.Content, result.ConfusablesNormalized = normalizeConfusables(result.Content)
+	normalizeTableConfusables(result.Tables)
+	for i := range result.Pages {
+		result.Pages[i].Content, _ = normalizeConfusables(result.Pages[i].Content)
+		normalizeTableConfusables(result.Pages[i].Tables)
+	}
+}
+
+// normalizeTableConfusables normalizes the look-alike letters in the cells and
+// markdown of tables.
+func normalizeTableConfusables(tables []Table) {
+	for i := range tables {
+		tables[i].Markdown, _ = normalizeConfusables(tables[i].Markdown)
+		for _, row := range tables[i].Cells {
+			for j := range row {
+				row[j], _ = normalizeConfusables(row[j])
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package kreuzberg
+
+import "testing"
+
+func TestNormalizeConfusables(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+		count int
+	}{
+		{"cyrillic letter in latin word", "Thе quick fox", "The quick fox", 1},
+		{"latin letter in cyrillic word", "Привeт, мир", "Привет, мир", 1},
+		{"greek capital in latin word", "ΑPPLE pie", "APPLE pie", 1},
+		{"all confusable word uses line script", "Login to раураl now", "Login to paypal now", 5},
+		{"multilingual text is kept", "Москва and London, Αθήνα", "Москва and London, Αθήνα", 0},
+		{"ambiguous word is kept", "Москва сор cop", "Москва сор cop", 0},
+		{"each line has its own context", "Login to раураl\nВход в систeму", "Login to paypal\nВход в систему", 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, count := normalizeConfusables(tt.input)
+			if got != tt.want || count != tt.count {
+				t.Errorf("normalizeConfusables(%q) = %q, %d; want %q, %d", tt.input, got, count, tt.want, tt.count)
+			}
+		})
+	}
+}
+
+func TestFinalizeResult_NormalizeConfusables(t *testing.T) {
+	result := &ExtractionResult{
+		Content: "Pаy nоw",
+		Pages:   []PageContent{{PageNumber: 1, Content: "Pаy nоw"}},
+		Tables:  []Table{{Cells: [][]string{{"Tоtal"}}, Markdown: "| Tоtal |"}},
+	}
+	got, err := finalizeResult(result, NewExtractionConfig(WithNormalizeConfusables(true)))
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if got.Content != "Pay now" || got.Pages[0].Content != "Pay now" || got.ConfusablesNormalized != 2 {
+		t.Errorf("unexpected content %q / %q, count %d", got.Content, got.Pages[0].Content, got.ConfusablesNormalized)
+	}
+	if got.Tables[0].Cells[0][0] != "Total" || got.Tables[0].Markdown != "| Total |" {
+		t.Errorf("expected tables to be normalized, got %+v", got.Tables[0])
+	}
+}
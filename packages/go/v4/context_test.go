@@ -0,0 +1,35 @@
+package kreuzberg
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestWithContextAlreadyDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	hooks := 0
+	config := NewExtractionConfig(
+		WithProgressCallback(func(ProgressEvent) { hooks++ }),
+		WithOnComplete(func(string, *ExtractionResult, error) { hooks++ }),
+	)
+
+	if _, err := ExtractFileWithContext(ctx, "doc.pdf", config); !errors.Is(err, context.Canceled) {
+		t.Errorf("ExtractFileWithContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := ExtractBytesWithContext(ctx, []byte("text"), "text/plain", config); !errors.Is(err, context.Canceled) {
+		t.Errorf("ExtractBytesWithContext: expected context.Canceled, got %v", err)
+	}
+	if _, err := BatchExtractFilesWithContext(ctx, []string{"a.pdf", "b.pdf"}, config); !errors.Is(err, context.Canceled) {
+		t.Errorf("BatchExtractFilesWithContext: expected context.Canceled, got %v", err)
+	}
+	items := []BytesWithMime{{Data: []byte("text"), MimeType: "text/plain"}}
+	if _, err := BatchExtractBytesWithContext(ctx, items, config); !errors.Is(err, context.Canceled) {
+		t.Errorf("BatchExtractBytesWithContext: expected context.Canceled, got %v", err)
+	}
+	if hooks != 0 {
+		t.Errorf("expected no hooks to run once ctx is done, got %d calls", hooks)
+	}
+}
@@ -0,0 +1,118 @@
+package kreuzberg
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// dateMonths maps English and German month names and their abbreviations,
+// lowercased, to their month.
+var dateMonths = map[string]time.Month{
+	"january": time.January, "jan": time.January, "januar": time.January, "jänner": time.January,
+	"february": time.February, "feb": time.February, "februar": time.February,
+	"march": time.March, "mar": time.March, "märz": time.March, "mär": time.March, "mrz": time.March,
+	"april": time.April, "apr": time.April,
+	"may": time.May, "mai": time.May,
+	"june": time.June, "jun": time.June, "juni": time.June,
+	"july": time.July, "jul": time.July, "juli": time.July,
+	"august": time.August, "aug": time.August,
+	"september": time.September, "sep": time.September, "sept": time.September,
+	"october": time.October, "oct": time.October, "oktober": time.October, "okt": time.October,
+	"november": time.November, "nov": time.November,
+	"december": time.December, "dec": time.December, "dezember": time.December, "dez": time.December,
+}
+
+// dateMonthPattern matches any key of dateMonths, longest names first.
+var dateMonthPattern = func() string {
+	names := make([]string, 0, len(dateMonths))
+	for name := range dateMonths {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	return "(?i:" + strings.Join(names, "|") + ")"
+}()
+
+// datePattern describes one date notation: the expression and the submatch
+// indexes of its year, month, and day.
+type datePattern struct {
+	re               *regexp.Regexp
+	year, month, day int
+	// monthFirst marks numeric notations written month before day, which are
+	// read day first when the month would be out of range.
+	monthFirst bool
+}
+
+// datePatterns lists the absolute date notations found by extractDates.
+var datePatterns = []datePattern{
+	// 2024-03-15
+	{re: regexp.MustCompile(`\b(\d{4})-(\d{2})-(\d{2})\b`), year: 1, month: 2, day: 3},
+	// 15.03.2024
+	{re: regexp.MustCompile(`\b(\d{1,2})\.(\d{1,2})\.(\d{4})\b`), year: 3, month: 2, day: 1},
+	// 03/15/2024
+	{re: regexp.MustCompile(`\b(\d{1,2})/(\d{1,2})/(\d{4})\b`), year: 3, month: 1, day: 2, monthFirst: true},
+	// 15 March 2024, 15. März 2024, 15th Mar. 2024
+	{re: regexp.MustCompile(`\b(\d{1,2})(?:\.|st|nd|rd|th)?[ \t]+(` + dateMonthPattern + `)\.?,?[ \t]+(\d{4})\b`), year: 3, month: 2, day: 1},
+	// March 15, 2024, Mar. 15th 2024
+	{re: regexp.MustCompile(`\b(` + dateMonthPattern + `)\.?[ \t]+(\d{1,2})(?:st|nd|rd|th)?,?[ \t]+(\d{4})\b`), year: 3, month: 1, day: 2},
+}
+
+// extractDates returns the absolute dates in content, in English and German
+// notations, in content order. Matches that are not valid calendar dates are
+// skipped, and overlapping matches keep the one that starts first.
+func extractDates(content string) []ExtractedDate {
+	type match struct {
+		start, end int
+		date       time.Time
+	}
+	var matches []match
+	for _, pattern := range datePatterns {
+		for _, loc := range pattern.re.FindAllStringSubmatchIndex(content, -1) {
+			group := func(i int) string { return content[loc[2*i]:loc[2*i+1]] }
+			year, _ := strconv.Atoi(group(pattern.year))
+			day, _ := strconv.Atoi(group(pattern.day))
+			month, ok := dateMonths[strings.ToLower(group(pattern.month))]
+			if !ok {
+				n, _ := strconv.Atoi(group(pattern.month))
+				if pattern.monthFirst && n > 12 && day <= 12 {
+					n, day = day, n
+				}
+				month = time.Month(n)
+			}
+			date, ok := validDate(year, month, day)
+			if !ok {
+				continue
+			}
+			matches = append(matches, match{start: loc[0], end: loc[1], date: date})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].start < matches[j].start })
+
+	var dates []ExtractedDate
+	end := 0
+	for _, m := range matches {
+		if m.start < end {
+			continue
+		}
+		end = m.end
+		dates = append(dates, ExtractedDate{
+			Raw:        content[m.start:m.end],
+			Normalized: m.date.Format(time.RFC3339),
+			Offset:     uint64(utf8.RuneCountInString(content[:m.start])),
+		})
+	}
+	return dates
+}
+
+// validDate returns the date at midnight UTC, and false when the values do not
+// form a calendar date, e.g. 31 February.
+func validDate(year int, month time.Month, day int) (time.Time, bool) {
+	if month < time.January || month > time.December || day < 1 {
+		return time.Time{}, false
+	}
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return date, date.Day() == day && date.Month() == month
+}
@@ -0,0 +1,64 @@
+package kreuzberg
+
+import "testing"
+
+func TestExtractDates(t *testing.T) {
+	tests := []struct {
+		in   string
+		raw  string
+		want string
+	}{
+		{"Signed on 2024-03-15.", "2024-03-15", "2024-03-15T00:00:00Z"},
+		{"Datum: 15.03.2024", "15.03.2024", "2024-03-15T00:00:00Z"},
+		{"Due 03/15/2024", "03/15/2024", "2024-03-15T00:00:00Z"},
+		{"Due 15/03/2024", "15/03/2024", "2024-03-15T00:00:00Z"},
+		{"on 15 March 2024", "15 March 2024", "2024-03-15T00:00:00Z"},
+		{"am 3. März 2024", "3. März 2024", "2024-03-03T00:00:00Z"},
+		{"on March 15, 2024", "March 15, 2024", "2024-03-15T00:00:00Z"},
+		{"by Mar. 1st 2024", "Mar. 1st 2024", "2024-03-01T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			dates := extractDates(tt.in)
+			if len(dates) != 1 {
+				t.Fatalf("extractDates(%q) = %+v, want one date", tt.in, dates)
+			}
+			if dates[0].Raw != tt.raw || dates[0].Normalized != tt.want {
+				t.Errorf("extractDates(%q) = %+v, want %q normalized to %q", tt.in, dates[0], tt.raw, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractDatesSkipsInvalidAndOrdersByOffset(t *testing.T) {
+	dates := extractDates("Größe 31.02.2024, Start 2024-01-02, Ende 5. Mai 2025, v1.2.3")
+	if len(dates) != 2 {
+		t.Fatalf("expected two dates, got %+v", dates)
+	}
+	if dates[0].Normalized != "2024-01-02T00:00:00Z" || dates[1].Normalized != "2025-05-05T00:00:00Z" {
+		t.Errorf("unexpected dates %+v", dates)
+	}
+	if dates[0].Offset != 24 {
+		t.Errorf("expected a character offset of 24, got %d", dates[0].Offset)
+	}
+}
+
+func TestFinalizeResult_DateExtraction(t *testing.T) {
+	result := &ExtractionResult{Content: "Issued 2024-03-15"}
+	if _, err := finalizeResult(result, NewExtractionConfig(WithDateExtraction(true))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.Dates) != 1 || result.Dates[0].Offset != 7 {
+		t.Errorf("expected the date to be found on the Go side, got %+v", result.Dates)
+	}
+
+	native := []ExtractedDate{{Raw: "March 2024", Normalized: "2024-03-01T00:00:00Z"}}
+	result = &ExtractionResult{Content: "Issued 2024-03-15", Dates: native}
+	if _, err := finalizeResult(result, NewExtractionConfig(WithDateExtraction(true))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.Dates) != 1 || result.Dates[0].Raw != "March 2024" {
+		t.Errorf("expected the native dates to be kept, got %+v", result.Dates)
+	}
+}
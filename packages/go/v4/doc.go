@@ -106,6 +106,16 @@
 //		fmt.Printf("[%d] %s => %d bytes\n", i, res.MimeType, len(res.Content))
 //	}
 //
+// To stream results as they complete, range over BatchExtractFilesSeq:
+//
+//	for item, err := range kreuzberg.BatchExtractFilesSeq(ctx, paths, nil) {
+//		if err != nil {
+//			log.Println(err)
+//			continue
+//		}
+//		fmt.Println(item.Path, item.Result.MimeType)
+//	}
+//
 // # Concurrency and Goroutines
 //
 // All extraction functions are synchronous and block until completion.
@@ -154,6 +164,15 @@
 //		}
 //	}
 //
+// # Temporary Files
+//
+// When a directory is set with WithTempDir, intermediate files written during
+// rendering and OCR go to a private directory per extraction call, created inside
+// it. The directory is removed when the call returns, including on error or panic.
+// Without WithTempDir the native core manages its intermediate files itself.
+// An unusable temp dir is reported as a *TempDirError. The kreuzbergtest package
+// provides AssertNoTempLeaks for verifying cleanup in tests.
+//
 // # Metadata Types
 //
 // Each document format supports format-specific metadata. Use the FormatType() method
@@ -255,6 +274,12 @@
 //
 //	RUST_LOG=kreuzberg=debug go test ./...
 //
+// Or set the level programmatically at startup, before the first extraction:
+//
+//	if err := kreuzberg.SetFFILogLevel("off"); err != nil {
+//		log.Fatal(err)
+//	}
+//
 // # Thread Safety
 //
 // All Kreuzberg API functions are thread-safe. The underlying Rust core and FFI
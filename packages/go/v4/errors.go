@@ -31,6 +31,7 @@ const (
 	ErrorKindPlugin            ErrorKind = "plugin"
 	ErrorKindUnsupportedFormat ErrorKind = "unsupported_format"
 	ErrorKindRuntime           ErrorKind = "runtime"
+	ErrorKindNetwork           ErrorKind = "network"
 )
 
 // ErrorCode represents FFI error codes from kreuzberg-ffi.
@@ -166,6 +167,20 @@ type RuntimeError struct {
 	baseError
 }
 
+// NetworkError reports a failure to fetch a document from a URL, as opposed to a
+// failure to extract it. StatusCode is 0 when no HTTP response was received.
+type NetworkError struct {
+	baseError
+	URL        string
+	StatusCode int
+}
+
+// TempDirError reports a temporary directory that cannot be used for intermediate files.
+type TempDirError struct {
+	baseError
+	Dir string
+}
+
 func makeBaseError(kind ErrorKind, message string, cause error, code ErrorCode, panicCtx *PanicContext) baseError {
 	var msg string
 	if panicCtx != nil {
@@ -231,6 +246,21 @@ func newIOErrorWithContext(message string, cause error, code ErrorCode, panicCtx
 	return &IOError{baseError: makeBaseError(ErrorKindIO, message, cause, code, panicCtx)}
 }
 
+func newNetworkErrorWithContext(url string, statusCode int, message string, cause error, code ErrorCode, panicCtx *PanicContext) *NetworkError {
+	return &NetworkError{
+		baseError:  makeBaseError(ErrorKindNetwork, messageWithFallback(message, fmt.Sprintf("Failed to fetch %s", url)), cause, code, panicCtx),
+		URL:        url,
+		StatusCode: statusCode,
+	}
+}
+
+func newTempDirErrorWithContext(dir string, message string, cause error, code ErrorCode, panicCtx *PanicContext) *TempDirError {
+	return &TempDirError{
+		baseError: makeBaseError(ErrorKindIO, messageWithFallback(message, fmt.Sprintf("Temporary directory is not writable: %s", dir)), cause, code, panicCtx),
+		Dir:       dir,
+	}
+}
+
 func newRuntimeErrorWithContext(message string, cause error, code ErrorCode, panicCtx *PanicContext) *RuntimeError {
 	return &RuntimeError{baseError: makeBaseError(ErrorKindRuntime, message, cause, code, panicCtx)}
 }
@@ -41,6 +41,17 @@ func TestExtractFileSyncWithEmptyPath(t *testing.T) {
 	}
 }
 
+// TestExtractFirstPageKeepsCallerConfig tests that ExtractFirstPage does not modify the caller's config.
+func TestExtractFirstPageKeepsCallerConfig(t *testing.T) {
+	config := NewExtractionConfig(WithUseCache(false))
+	if _, err := ExtractFirstPage("", config); err == nil {
+		t.Fatalf("expected error for empty path, got nil")
+	}
+	if config.Pages != nil {
+		t.Errorf("expected caller config to be unchanged, got pages %+v", config.Pages)
+	}
+}
+
 // TestExtractFileSyncWithConfig tests extraction with custom configuration.
 func TestExtractFileSyncWithConfig(t *testing.T) {
 	dir := t.TempDir()
@@ -0,0 +1,188 @@
+package kreuzberg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"image/png"
+	"sync"
+)
+
+// ErrExtractorClosed is the cause of the *RuntimeError returned by an Extractor's
+// methods after Close was called. Test for it with errors.Is.
+var ErrExtractorClosed = errors.New("kreuzberg: extractor closed")
+
+// Extractor runs extractions with a fixed config, for long-running services:
+//
+//	extractor, err := kreuzberg.NewExtractor(config)
+//	if err != nil {
+//		return err
+//	}
+//	defer extractor.Close()
+//	result, err := extractor.ExtractFile("report.pdf")
+//
+// The config is validated once by NewExtractor and copied, so later changes to
+// the caller's config do not affect the Extractor. The native library keeps
+// PDFium and its other process-wide state loaded across calls, so no setup is
+// repeated per extraction either way; the Extractor adds config reuse and a
+// Close that waits for its own extractions.
+//
+// An Extractor is safe for concurrent use, with the same serialization of native
+// calls as ExtractFileSync and ExtractBytesSync.
+type Extractor struct {
+	config *ExtractionConfig
+
+	mu       sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+// ExtractorOptions controls how NewExtractor sets up an Extractor.
+type ExtractorOptions struct {
+	// Prewarm runs warm-up extractions before NewExtractor returns: a small
+	// built-in PDF with the Extractor's config, which loads PDFium and any models
+	// the config uses, and an image as well when OCR is configured. Go-side hooks
+	// are not invoked and nothing is cached for the warm-up documents.
+	// Default: false.
+	Prewarm bool
+}
+
+// ExtractorOption is a functional option for configuring ExtractorOptions.
+type ExtractorOption func(*ExtractorOptions)
+
+// WithPrewarm makes NewExtractor pay the native library's one-time setup costs
+// before it returns, so the first real extraction is not slower than the rest.
+func WithPrewarm(enabled bool) ExtractorOption {
+	return func(o *ExtractorOptions) {
+		o.Prewarm = enabled
+	}
+}
+
+// NewExtractor returns an Extractor that uses a copy of config. It fails with a
+// *ValidationError when config does not pass ExtractionConfig.Validate, and with
+// the warm-up extraction's error when prewarming is enabled and fails.
+func NewExtractor(config *ExtractionConfig, opts ...ExtractorOption) (*Extractor, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	o := &ExtractorOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	e := &Extractor{config: cloneConfig(config)}
+	if o.Prewarm {
+		if err := e.prewarm(); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// ExtractFile extracts the file at path like ExtractFileSync.
+func (e *Extractor) ExtractFile(path string) (*ExtractionResult, error) {
+	if err := e.begin(); err != nil {
+		return nil, err
+	}
+	defer e.inFlight.Done()
+	return ExtractFileSync(path, e.config)
+}
+
+// ExtractBytes extracts data like ExtractBytesSync.
+func (e *Extractor) ExtractBytes(data []byte, mimeType string) (*ExtractionResult, error) {
+	if err := e.begin(); err != nil {
+		return nil, err
+	}
+	defer e.inFlight.Done()
+	return ExtractBytesSync(data, mimeType, e.config)
+}
+
+// Close stops the Extractor from accepting new extractions and waits for its
+// in-flight ones to return. Later calls fail with an error wrapping
+// ErrExtractorClosed. Close is idempotent and always returns nil.
+func (e *Extractor) Close() error {
+	e.mu.Lock()
+	e.closed = true
+	e.mu.Unlock()
+	e.inFlight.Wait()
+	return nil
+}
+
+// begin registers an in-flight extraction unless the Extractor is closed.
+func (e *Extractor) begin() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return newRuntimeErrorWithContext("extraction rejected after Close", ErrExtractorClosed, ErrorCodeInternal, nil)
+	}
+	e.inFlight.Add(1)
+	return nil
+}
+
+// prewarm runs the warm-up extractions for WithPrewarm.
+func (e *Extractor) prewarm() error {
+	config := prewarmConfig(e.config)
+	for _, input := range prewarmInputs(config) {
+		if _, err := ExtractBytesSync(input.Data, input.MimeType, config); err != nil {
+			return newRuntimeErrorWithContext(fmt.Sprintf("prewarm extraction of %s failed", input.MimeType), err, ErrorCodeInternal, nil)
+		}
+	}
+	return nil
+}
+
+// prewarmConfig returns a copy of config for warm-up extractions, without Go-side
+// hooks and caching.
+func prewarmConfig(config *ExtractionConfig) *ExtractionConfig {
+	cfg := cloneConfig(config)
+	cfg.ContentCallback = nil
+	cfg.OnComplete = nil
+	cfg.ContentValidator = nil
+	cfg.ProgressCallback = nil
+	cfg.UseCache = BoolPtr(false)
+	return cfg
+}
+
+// prewarmInputs returns the documents extracted by prewarm for config.
+func prewarmInputs(config *ExtractionConfig) []BytesWithMime {
+	inputs := []BytesWithMime{{Data: prewarmPDF(), MimeType: "application/pdf"}}
+	if config.OCR != nil {
+		inputs = append(inputs, BytesWithMime{Data: prewarmPNG(), MimeType: "image/png"})
+	}
+	return inputs
+}
+
+// prewarmPDF returns a minimal one-page PDF with a line of text.
+func prewarmPDF() []byte {
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 200 100] /Contents 4 0 R /Resources << /Font << /F1 5 0 R >> >> >>",
+		"<< /Length 34 >>\nstream\nBT /F1 12 Tf 20 50 Td (warm) Tj ET\nendstream",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+	}
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes()
+}
+
+// prewarmPNG returns a small blank PNG image.
+func prewarmPNG() []byte {
+	img := image.NewGray(image.Rect(0, 0, 32, 32))
+	draw.Draw(img, img.Bounds(), image.White, image.Point{}, draw.Src)
+	var b bytes.Buffer
+	_ = png.Encode(&b, img)
+	return b.Bytes()
+}
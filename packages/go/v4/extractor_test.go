@@ -0,0 +1,122 @@
+package kreuzberg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image/png"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewExtractorValidatesConfig(t *testing.T) {
+	if _, err := NewExtractor(NewExtractionConfig(WithPages(WithPageNumbers(0)))); err == nil {
+		t.Error("expected invalid config to be rejected")
+	}
+
+	config := NewExtractionConfig(WithOutputFormat("markdown"))
+	extractor, err := NewExtractor(config)
+	if err != nil {
+		t.Fatalf("NewExtractor failed: %v", err)
+	}
+	config.OutputFormat = "html"
+	if extractor.config.OutputFormat != "markdown" {
+		t.Error("expected extractor to keep its own copy of the config")
+	}
+}
+
+func TestExtractorCloseRejectsNewExtractions(t *testing.T) {
+	extractor, err := NewExtractor(nil)
+	if err != nil {
+		t.Fatalf("NewExtractor failed: %v", err)
+	}
+	if err := extractor.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if err := extractor.Close(); err != nil {
+		t.Errorf("expected second Close to succeed, got %v", err)
+	}
+
+	_, err = extractor.ExtractBytes([]byte("hello"), "text/plain")
+	if !errors.Is(err, ErrExtractorClosed) {
+		t.Errorf("expected ErrExtractorClosed, got %v", err)
+	}
+	if _, err := extractor.ExtractFile("report.pdf"); !errors.Is(err, ErrExtractorClosed) {
+		t.Errorf("expected ErrExtractorClosed from ExtractFile, got %v", err)
+	}
+}
+
+func TestExtractorCloseWaitsForInFlight(t *testing.T) {
+	extractor, err := NewExtractor(nil)
+	if err != nil {
+		t.Fatalf("NewExtractor failed: %v", err)
+	}
+	if err := extractor.begin(); err != nil {
+		t.Fatalf("begin failed: %v", err)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		extractor.Close()
+		close(closed)
+	}()
+
+	select {
+	case <-closed:
+		t.Fatal("expected Close to wait for the in-flight extraction")
+	case <-time.After(20 * time.Millisecond):
+	}
+	extractor.inFlight.Done()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("expected Close to return once the extraction finished")
+	}
+}
+
+func TestPrewarmConfig(t *testing.T) {
+	config := NewExtractionConfig(
+		WithUseCache(true),
+		WithContentValidator(func(*ExtractionResult) error { return errors.New("too short") }),
+		WithOutputFormat("markdown"),
+	)
+	cfg := prewarmConfig(config)
+
+	if cfg.ContentValidator != nil || cfg.UseCache == nil || *cfg.UseCache {
+		t.Error("expected hooks and caching to be disabled for warm-up")
+	}
+	if cfg.OutputFormat != "markdown" {
+		t.Error("expected the rest of the config to be kept")
+	}
+	if config.ContentValidator == nil || !*config.UseCache {
+		t.Error("expected the original config to be unchanged")
+	}
+}
+
+func TestPrewarmInputs(t *testing.T) {
+	inputs := prewarmInputs(NewExtractionConfig())
+	if len(inputs) != 1 || inputs[0].MimeType != "application/pdf" {
+		t.Fatalf("expected only the PDF without OCR, got %d inputs", len(inputs))
+	}
+	pdf := string(inputs[0].Data)
+	if !strings.HasPrefix(pdf, "%PDF-1.4\n") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Errorf("unexpected PDF framing: %q", pdf)
+	}
+	xref := strings.Index(pdf, "xref\n")
+	if !strings.Contains(pdf, fmt.Sprintf("startxref\n%d\n", xref)) {
+		t.Error("expected startxref to point at the xref table")
+	}
+	if obj := strings.Index(pdf, "3 0 obj"); !strings.Contains(pdf, fmt.Sprintf("%010d 00000 n", obj)) {
+		t.Error("expected the xref table to hold the object offsets")
+	}
+
+	inputs = prewarmInputs(NewExtractionConfig(WithOCR(WithOCRBackend("tesseract"))))
+	if len(inputs) != 2 || inputs[1].MimeType != "image/png" {
+		t.Fatalf("expected an image for OCR warm-up, got %d inputs", len(inputs))
+	}
+	img, err := png.Decode(bytes.NewReader(inputs[1].Data))
+	if err != nil || img.Bounds().Dx() != 32 {
+		t.Errorf("expected a valid PNG, got %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package kreuzberg
+
+import "strings"
+
+// SplitOnFormFeed splits content into the logical documents delimited by form
+// feed characters ("\f"). Line breaks next to a form feed are removed, and
+// documents that contain only whitespace, such as after a trailing form feed,
+// are dropped. Content without form feeds is returned as a single document
+// unless it is blank.
+func SplitOnFormFeed(content string) []string {
+	var documents []string
+	for _, part := range strings.Split(content, "\f") {
+		part = strings.Trim(part, "\r\n")
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		documents = append(documents, part)
+	}
+	return documents
+}
+
+// splitConcatenatedDocuments returns a child result for every logical document in
+// result.Content.
+func splitConcatenatedDocuments(result *ExtractionResult) []*ExtractionResult {
+	documents := SplitOnFormFeed(result.Content)
+	children := make([]*ExtractionResult, len(documents))
+	for i, content := range documents {
+		children[i] = &ExtractionResult{Content: content, MimeType: result.MimeType}
+	}
+	return children
+}
@@ -0,0 +1,53 @@
+package kreuzberg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitOnFormFeed(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"none", "single report\nline two", []string{"single report\nline two"}},
+		{"blank", " \n", nil},
+		{"separated", "first\n\fsecond\r\n\f\nthird", []string{"first", "second", "third"}},
+		{"trailing", "first\f\n", []string{"first"}},
+		{"empty documents", "\f\f  \fonly", []string{"only"}},
+		{"keeps indentation", "    HEADER\n\f    PAGE 2", []string{"    HEADER", "    PAGE 2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SplitOnFormFeed(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitOnFormFeed(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinalizeResult_SplitConcatenatedDocuments(t *testing.T) {
+	result := &ExtractionResult{Content: "INVOICE 1\fINVOICE 2\n", MimeType: "text/plain"}
+	if _, err := finalizeResult(result, NewExtractionConfig(WithSplitConcatenatedDocuments(true))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.Children) != 2 {
+		t.Fatalf("expected 2 children, got %d", len(result.Children))
+	}
+	if result.Children[1].Content != "INVOICE 2" || result.Children[1].MimeType != "text/plain" {
+		t.Errorf("unexpected child: %+v", result.Children[1])
+	}
+	if result.Content != "INVOICE 1\fINVOICE 2\n" {
+		t.Error("expected the parent to keep the whole content")
+	}
+
+	result = &ExtractionResult{Content: "INVOICE 1\fINVOICE 2"}
+	if _, err := finalizeResult(result, NewExtractionConfig()); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Children != nil {
+		t.Error("expected no children when splitting is disabled")
+	}
+}
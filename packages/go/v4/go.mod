@@ -4,3 +4,8 @@ go 1.25
 
 // Local development; remove this section for published releases
 // replace github.com/kreuzberg-dev/kreuzberg => ../../
+
+require (
+	github.com/pelletier/go-toml/v2 v2.4.3
+	gopkg.in/yaml.v3 v3.0.1
+)
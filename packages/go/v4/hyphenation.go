@@ -0,0 +1,162 @@
+package kreuzberg
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// HyphenationLanguageAuto selects the dehyphenation rules of the language
+// detected in the document, which requires language detection or a language in
+// the document metadata; see WithHyphenationLanguage.
+//
+// German rules keep suspended compounds such as "Haupt- und Nebensatz", English
+// rules keep prefixes such as "self-aware", and French rules keep inverted
+// pronouns such as "dit-il". Hyphens after single letters or numbers, or before
+// capitalized words, are kept in every language.
+const HyphenationLanguageAuto = "auto"
+
+// hyphenationRules decides, for one language, whether a hyphen at a line break
+// belongs to the text or was inserted to break a word across lines.
+type hyphenationRules struct {
+	// suspended lists words that follow a suspended compound, as in German
+	// "Haupt- und Nebensatz"; the hyphen is kept and the line break becomes a space.
+	suspended map[string]bool
+	// prefixes lists words that are always joined to the next word with a hyphen,
+	// as in English "self-aware".
+	prefixes map[string]bool
+	// suffixes lists words that are always attached with a hyphen, as in French
+	// "dit-il".
+	suffixes map[string]bool
+}
+
+// hyphenationLanguages maps ISO 639-1 and 639-3 codes to their dehyphenation rules.
+// Other languages use defaultHyphenationRules.
+var hyphenationLanguages = func() map[string]*hyphenationRules {
+	german := &hyphenationRules{
+		suspended: wordSet("und", "oder", "bzw", "sowie", "bis", "noch"),
+	}
+	english := &hyphenationRules{
+		suspended: wordSet("and", "or", "to", "nor"),
+		prefixes:  wordSet("self", "ex", "all", "quasi", "half"),
+	}
+	french := &hyphenationRules{
+		suspended: wordSet("et", "ou"),
+		suffixes:  wordSet("il", "ils", "elle", "elles", "on", "je", "tu", "nous", "vous", "t", "ci", "là", "même", "être"),
+	}
+	dutch := &hyphenationRules{
+		suspended: wordSet("en", "of", "tot"),
+	}
+	return map[string]*hyphenationRules{
+		"de": german, "deu": german, "ger": german,
+		"en": english, "eng": english,
+		"fr": french, "fra": french, "fre": french,
+		"nl": dutch, "nld": dutch, "dut": dutch,
+	}
+}()
+
+// defaultHyphenationRules apply to languages without specific rules.
+var defaultHyphenationRules = &hyphenationRules{}
+
+// lineBreakHyphen matches a word, a hyphen ending its line, and the word starting
+// the next line.
+var lineBreakHyphen = regexp.MustCompile(`([\p{L}\p{N}]+)-[ \t]*\r?\n[ \t]*([\p{L}\p{N}]+)`)
+
+// wordSet returns a set of words.
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+	return set
+}
+
+// lookupHyphenationRules returns the rules for a language code such as "de",
+// "deu", or "de-DE".
+func lookupHyphenationRules(code string) *hyphenationRules {
+	code = strings.ToLower(code)
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	if rules, ok := hyphenationLanguages[code]; ok {
+		return rules
+	}
+	return defaultHyphenationRules
+}
+
+// ValidateHyphenationLanguage validates a language for WithHyphenationLanguage:
+// HyphenationLanguageAuto or a language code accepted by ValidateLanguageCode.
+func ValidateHyphenationLanguage(code string) error {
+	if code == HyphenationLanguageAuto {
+		return nil
+	}
+	if code == "" {
+		return newValidationErrorWithContext("hyphenation language cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+	return ValidateLanguageCode(code)
+}
+
+// dehyphenate joins the words that rules say were broken across lines and keeps
+// the hyphens that belong to the text.
+func (rules *hyphenationRules) dehyphenate(text string) string {
+	if !strings.Contains(text, "-") {
+		return text
+	}
+	return lineBreakHyphen.ReplaceAllStringFunc(text, func(match string) string {
+		parts := lineBreakHyphen.FindStringSubmatch(match)
+		before, after := parts[1], parts[2]
+		lowerBefore, lowerAfter := strings.ToLower(before), strings.ToLower(after)
+		switch {
+		case rules.suspended[lowerAfter]:
+			return before + "- " + after
+		case rules.keepsHyphen(before, after, lowerBefore, lowerAfter):
+			return before + "-" + after
+		default:
+			return before + after
+		}
+	})
+}
+
+// keepsHyphen reports whether the hyphen between before and after is part of a
+// hyphenated word rather than a line-break hyphen.
+func (rules *hyphenationRules) keepsHyphen(before, after, lowerBefore, lowerAfter string) bool {
+	if rules.prefixes[lowerBefore] || rules.suffixes[lowerAfter] {
+		return true
+	}
+	// Single letters ("E-Mail"), numbers ("5-fach"), and capitalized words after
+	// the break ("Ost-West", "Anglo-Saxon") form compounds rather than syllables.
+	if utf8.RuneCountInString(before) == 1 {
+		return true
+	}
+	last, _ := utf8.DecodeLastRuneInString(before)
+	first, _ := utf8.DecodeRuneInString(after)
+	return unicode.IsDigit(last) || unicode.IsDigit(first) || unicode.IsUpper(first)
+}
+
+// documentLanguage returns the language detected in result, or "" when none was.
+func documentLanguage(result *ExtractionResult) string {
+	switch {
+	case len(result.LanguageConfidences) > 0:
+		return result.LanguageConfidences[0].Code
+	case len(result.DetectedLanguages) > 0:
+		return result.DetectedLanguages[0]
+	case result.Metadata.Language != nil:
+		return *result.Metadata.Language
+	}
+	return ""
+}
+
+// dehyphenateResult removes line-break hyphens from the result's content and page
+// texts using the rules of language, or of the detected document language when
+// language is HyphenationLanguageAuto.
+func dehyphenateResult(result *ExtractionResult, language string) {
+	if language == HyphenationLanguageAuto {
+		language = documentLanguage(result)
+	}
+	rules := lookupHyphenationRules(language)
+	result.Content = rules.dehyphenate(result.Content)
+	for i := range result.Pages {
+		result.Pages[i].Content = rules.dehyphenate(result.Pages[i].Content)
+	}
+}
@@ -0,0 +1,65 @@
+package kreuzberg
+
+import "testing"
+
+func TestDehyphenate(t *testing.T) {
+	tests := []struct {
+		language string
+		input    string
+		want     string
+	}{
+		{"de", "Der Arbeits-\nmarkt wächst.", "Der Arbeitsmarkt wächst."},
+		{"de", "Haupt-\nund Nebensatz", "Haupt- und Nebensatz"},
+		{"de", "Die Ost-\nWest-Achse", "Die Ost-West-Achse"},
+		{"deu", "Eine E-\nMail", "Eine E-Mail"},
+		{"de-DE", "5-\nfach", "5-fach"},
+		{"en", "a self-\naware system", "a self-aware system"},
+		{"en", "pre-\nand post-processing", "pre- and post-processing"},
+		{"en", "extrac-\ntion", "extraction"},
+		{"fr", "dit-\nil", "dit-il"},
+		{"fr", "peut-\nêtre", "peut-être"},
+		{"sv", "self-\naware", "selfaware"},
+		{"de", "Liste:\n- Punkt", "Liste:\n- Punkt"},
+		{"de", "Bindestrich-  \r\n  wort", "Bindestrichwort"},
+	}
+	for _, tt := range tests {
+		if got := lookupHyphenationRules(tt.language).dehyphenate(tt.input); got != tt.want {
+			t.Errorf("dehyphenate(%q, %q) = %q, want %q", tt.language, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestFinalizeResult_HyphenationLanguageAuto(t *testing.T) {
+	result := &ExtractionResult{
+		Content:             "Haupt-\nund Neben-\nsatz",
+		Pages:               []PageContent{{PageNumber: 1, Content: "Neben-\nsatz"}},
+		LanguageConfidences: []DetectedLanguage{{Code: "deu", Confidence: 0.9}},
+	}
+	got, err := finalizeResult(result, NewExtractionConfig(WithHyphenationLanguage(HyphenationLanguageAuto)))
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if want := "Haupt- und Nebensatz"; got.Content != want {
+		t.Errorf("content = %q, want %q", got.Content, want)
+	}
+	if want := "Nebensatz"; got.Pages[0].Content != want {
+		t.Errorf("page content = %q, want %q", got.Pages[0].Content, want)
+	}
+
+	untouched, err := finalizeResult(&ExtractionResult{Content: "Neben-\nsatz"}, &ExtractionConfig{})
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if untouched.Content != "Neben-\nsatz" {
+		t.Errorf("expected content to be left alone by default, got %q", untouched.Content)
+	}
+}
+
+func TestValidateHyphenationLanguage(t *testing.T) {
+	if err := ValidateHyphenationLanguage(HyphenationLanguageAuto); err != nil {
+		t.Errorf("expected auto to be valid, got %v", err)
+	}
+	if err := ValidateHyphenationLanguage(""); err == nil {
+		t.Error("expected error for empty hyphenation language")
+	}
+}
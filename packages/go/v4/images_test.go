@@ -2,6 +2,7 @@ package kreuzberg
 
 import (
 	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -467,3 +468,81 @@ func getTestFilePath(relativePath string) string {
 	repoRoot := filepath.Join(wd, "..", "..", "..")
 	return filepath.Join(repoRoot, "test_documents", relativePath)
 }
+
+// TestExtractedImageBoundingBoxDecoding tests decoding of an image's position on its page.
+func TestExtractedImageBoundingBoxDecoding(t *testing.T) {
+	var images []ExtractedImage
+	payload := `[{"data":"AAEC","format":"jpeg","image_index":0,"page_number":2,"is_mask":false,
+		"bounding_box":{"x0":72,"y0":400,"x1":300,"y1":600}},
+		{"data":"","format":"png","image_index":1,"is_mask":false}]`
+	if err := json.Unmarshal([]byte(payload), &images); err != nil {
+		t.Fatalf("failed to decode images: %v", err)
+	}
+	first := images[0]
+	if !bytes.Equal(first.Data, []byte{0, 1, 2}) || first.PageNumber == nil || *first.PageNumber != 2 {
+		t.Errorf("unexpected image: %+v", first)
+	}
+	if first.BoundingBox == nil || first.BoundingBox.X0 != 72 || first.BoundingBox.Y1 != 600 {
+		t.Errorf("unexpected bounding box: %+v", first.BoundingBox)
+	}
+	if images[1].BoundingBox != nil {
+		t.Error("expected missing bounding box to stay nil")
+	}
+}
+
+func TestFinalizeResult_ExtractChecksums(t *testing.T) {
+	// SHA-256 of "logo".
+	const want = "3598ce6f965b2481fe26316c06b30950c46ac7f8e7229f104aa78f579997668d"
+	result := &ExtractionResult{
+		Images: []ExtractedImage{{Data: []byte("logo")}},
+		Pages:  []PageContent{{PageNumber: 1, Images: []ExtractedImage{{Data: []byte("logo")}}}},
+	}
+
+	got, err := finalizeResult(result, NewExtractionConfig(WithExtractChecksums(true)))
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if got.Images[0].Checksum != want || got.Pages[0].Images[0].Checksum != want {
+		t.Errorf("checksums = %q, %q, want %q", got.Images[0].Checksum, got.Pages[0].Images[0].Checksum, want)
+	}
+
+	plain, err := finalizeResult(&ExtractionResult{Images: []ExtractedImage{{Data: []byte("logo")}}}, &ExtractionConfig{})
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if plain.Images[0].Checksum != "" {
+		t.Errorf("expected no checksum by default, got %q", plain.Images[0].Checksum)
+	}
+}
+
+func TestFinalizeResult_ExtractInlineImages(t *testing.T) {
+	var images []ExtractedImage
+	if err := json.Unmarshal([]byte(`[{"data": "", "format": "png", "image_index": 0, "is_mask": false, "kind": "xobject"},
+		{"data": "", "format": "png", "image_index": 1, "is_mask": false, "kind": "inline"}]`), &images); err != nil {
+		t.Fatalf("failed to decode images: %v", err)
+	}
+	if images[1].Kind != ImageKindInline {
+		t.Fatalf("expected inline kind, got %q", images[1].Kind)
+	}
+
+	enabled := NewExtractionConfig(WithImages(WithExtractInlineImages(true)))
+	got, err := finalizeResult(&ExtractionResult{Images: append([]ExtractedImage(nil), images...)}, enabled)
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if len(got.Images) != 2 {
+		t.Errorf("expected inline images to be kept, got %+v", got.Images)
+	}
+
+	disabled := NewExtractionConfig(WithImages(WithExtractInlineImages(false)))
+	got, err = finalizeResult(&ExtractionResult{
+		Images: append([]ExtractedImage(nil), images...),
+		Pages:  []PageContent{{PageNumber: 1, Images: append([]ExtractedImage(nil), images...)}},
+	}, disabled)
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if len(got.Images) != 1 || got.Images[0].Kind != ImageKindXObject || len(got.Pages[0].Images) != 1 {
+		t.Errorf("expected inline images to be dropped, got %+v and %+v", got.Images, got.Pages[0].Images)
+	}
+}
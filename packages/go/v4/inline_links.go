@@ -0,0 +1,85 @@
+package kreuzberg
+
+import "strings"
+
+// rewriteMarkdownLinks replaces inline markdown links ("[text](url "title")") in
+// text with their URL or anchor text, according to style. Images, reference
+// links, autolinks, code spans, and fenced code blocks are left untouched.
+func rewriteMarkdownLinks(text, style string) string {
+	if !strings.Contains(text, "](") {
+		return text
+	}
+
+	lines := strings.Split(text, "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if !inFence {
+			lines[i] = rewriteLinkLine(line, style)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// rewriteLinkLine rewrites the inline links of a single line outside of code blocks.
+func rewriteLinkLine(line, style string) string {
+	var b strings.Builder
+	changed := false
+	for i := 0; i < len(line); {
+		switch {
+		case line[i] == '`':
+			end := codeSpanEnd(line, i)
+			b.WriteString(line[i:end])
+			i = end
+			continue
+		case line[i] == '\\' && i+1 < len(line):
+			b.WriteString(line[i : i+2])
+			i += 2
+			continue
+		case strings.HasPrefix(line[i:], "!["):
+			if end := imageEnd(line, i); end > 0 {
+				b.WriteString(line[i:end])
+				i = end
+				continue
+			}
+		case line[i] == '[':
+			if textEnd := closingDelimiter(line, i, '[', ']'); textEnd > 0 && textEnd < len(line) && line[textEnd] == '(' {
+				if end := closingDelimiter(line, textEnd, '(', ')'); end > 0 {
+					if style == InlineLinkStyleURLOnly {
+						b.WriteString(linkDestination(line[textEnd+1 : end-1]))
+					} else {
+						b.WriteString(line[i+1 : textEnd-1])
+					}
+					changed = true
+					i = end
+					continue
+				}
+			}
+		}
+		b.WriteByte(line[i])
+		i++
+	}
+	if !changed {
+		return line
+	}
+	return b.String()
+}
+
+// linkDestination returns the URL of an inline link's "(url "title")" part,
+// given without the parentheses.
+func linkDestination(target string) string {
+	target = strings.TrimSpace(target)
+	if strings.HasPrefix(target, "<") {
+		if end := strings.IndexByte(target, '>'); end > 0 {
+			return target[1:end]
+		}
+	}
+	if end := strings.IndexAny(target, " \t"); end >= 0 {
+		return target[:end]
+	}
+	return target
+}
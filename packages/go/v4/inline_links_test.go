@@ -0,0 +1,51 @@
+package kreuzberg
+
+import "testing"
+
+func TestRewriteMarkdownLinks(t *testing.T) {
+	tests := []struct {
+		name  string
+		in    string
+		style string
+		want  string
+	}{
+		{"url only", "See [the docs](https://example.com/docs \"Docs\") now.", InlineLinkStyleURLOnly, "See https://example.com/docs now."},
+		{"text only", "See [the *docs*](https://example.com/docs) now.", InlineLinkStyleTextOnly, "See the *docs* now."},
+		{"angle destination", "[site](<https://example.com/a b>)", InlineLinkStyleURLOnly, "https://example.com/a b"},
+		{"parens in url", "[wiki](https://en.wikipedia.org/wiki/Go_(language))", InlineLinkStyleURLOnly, "https://en.wikipedia.org/wiki/Go_(language)"},
+		{"image kept", "![logo](logo.png) and [home](/)", InlineLinkStyleTextOnly, "![logo](logo.png) and home"},
+		{"reference link kept", "[text][ref] and [x]", InlineLinkStyleURLOnly, "[text][ref] and [x]"},
+		{"code kept", "`[a](b)` [c](d)\n```\n[e](f)\n```", InlineLinkStyleURLOnly, "`[a](b)` d\n```\n[e](f)\n```"},
+		{"escaped kept", `\[a](b)`, InlineLinkStyleTextOnly, `\[a](b)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rewriteMarkdownLinks(tt.in, tt.style); got != tt.want {
+				t.Errorf("rewriteMarkdownLinks(%q, %q) = %q, want %q", tt.in, tt.style, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFinalizeResult_InlineLinkStyle(t *testing.T) {
+	result := &ExtractionResult{Content: "Read [more](https://example.com)."}
+	if _, err := finalizeResult(result, NewExtractionConfig(WithInlineLinkStyle(InlineLinkStyleTextOnly))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "Read [more](https://example.com)." {
+		t.Errorf("expected non-markdown output to be left to the native core, got %q", result.Content)
+	}
+
+	config := NewExtractionConfig(WithOutputFormat("markdown"), WithInlineLinkStyle(InlineLinkStyleURLOnly))
+	if _, err := finalizeResult(result, config); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "Read https://example.com." {
+		t.Errorf("unexpected content: %q", result.Content)
+	}
+
+	if err := validateConfigBeforeFFI(NewExtractionConfig(WithInlineLinkStyle("footnote"))); err == nil {
+		t.Error("expected invalid link style to be rejected")
+	}
+}
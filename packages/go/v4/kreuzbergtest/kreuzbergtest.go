@@ -0,0 +1,29 @@
+// Package kreuzbergtest provides helpers for testing code that uses the Kreuzberg Go binding.
+package kreuzbergtest
+
+import (
+	"os"
+	"testing"
+)
+
+// AssertNoTempLeaks fails the test if dir contains any files or directories.
+//
+// Point extractions at a dedicated directory with kreuzberg.WithTempDir (or TMPDIR)
+// and call AssertNoTempLeaks after they return to verify that every intermediate
+// file was cleaned up, including on failed extractions:
+//
+//	dir := t.TempDir()
+//	_, _ = kreuzberg.ExtractFileSync(path, kreuzberg.NewExtractionConfig(kreuzberg.WithTempDir(dir)))
+//	kreuzbergtest.AssertNoTempLeaks(t, dir)
+func AssertNoTempLeaks(t testing.TB, dir string) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Errorf("failed to read temp dir %s: %v", dir, err)
+		return
+	}
+	for _, entry := range entries {
+		t.Errorf("leaked temp entry in %s: %s", dir, entry.Name())
+	}
+}
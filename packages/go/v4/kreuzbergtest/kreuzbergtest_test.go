@@ -0,0 +1,42 @@
+package kreuzbergtest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// recordingTB captures failures instead of failing the enclosing test.
+type recordingTB struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertNoTempLeaks_Empty(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	AssertNoTempLeaks(rec, t.TempDir())
+
+	if len(rec.errors) != 0 {
+		t.Errorf("expected no failures, got %v", rec.errors)
+	}
+}
+
+func TestAssertNoTempLeaks_Leaked(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "kreuzberg-123"), 0o700); err != nil {
+		t.Fatalf("failed to create leaked dir: %v", err)
+	}
+	rec := &recordingTB{TB: t}
+	AssertNoTempLeaks(rec, dir)
+
+	if len(rec.errors) != 1 {
+		t.Errorf("expected 1 failure, got %v", rec.errors)
+	}
+}
@@ -0,0 +1,125 @@
+package kreuzberg
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// listMarkerPattern matches the marker at the start of a list item line, after
+// indentation has been removed. Letters and roman numerals are only recognized with
+// the delimiters that are unambiguous in running text ("a)" and "i." / "i)"), so that
+// initials such as "A. Smith" are not mistaken for list items.
+var listMarkerPattern = regexp.MustCompile(`^(?:([•◦▪‣●○■–*-])|(\d{1,3})[.)]|([a-z])\)|(i{1,3}|iv|vi{0,3}|ix|x)[.)])[ \t]+(\S.*)$`)
+
+// multiLevelMarkerPattern matches hierarchical clause numbers such as "1.2" or
+// "1.2.3.", which are only recognized as list markers when numbering is preserved.
+var multiLevelMarkerPattern = regexp.MustCompile(`^(\d{1,3}(?:\.\d{1,3})+\.?)[ \t]+(\S.*)$`)
+
+// listLevel tracks one level of nesting while formatting a list.
+type listLevel struct {
+	indent  int
+	width   int
+	ordinal int
+}
+
+// isMarkdownOutput reports whether format requests markdown content.
+func isMarkdownOutput(format string) bool {
+	switch OutputFormat(format) {
+	case OutputFormatMarkdown, OutputFormatMd:
+		return true
+	default:
+		return false
+	}
+}
+
+// formatMarkdownLists rewrites bullet ("•", "-", "*", ...) and numbered ("1.",
+// "a)", "i.") list items in text as markdown list items. Nesting is derived from
+// the original indentation, and nested items are indented under their parent's
+// content. Lines inside fenced code blocks are left untouched.
+//
+// Letter and roman numeral items are renumbered as "1.", "2.", ... unless
+// preserveNumbering is set, in which case the original labels of numbered items,
+// including hierarchical numbers such as "1.2.3", are kept verbatim.
+func formatMarkdownLists(text string, preserveNumbering bool) string {
+	lines := strings.Split(text, "\n")
+	var stack []listLevel
+	inFence := false
+
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			stack = nil
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if trimmed == "" {
+			continue
+		}
+
+		match := listMarkerPattern.FindStringSubmatch(trimmed)
+		var label, content string
+		switch {
+		case match != nil:
+			content = match[5]
+			label = strings.TrimRight(trimmed[:len(trimmed)-len(content)], " \t")
+		case preserveNumbering:
+			if multi := multiLevelMarkerPattern.FindStringSubmatch(trimmed); multi != nil {
+				label, content = multi[1], multi[2]
+			}
+		}
+		if label == "" {
+			stack = nil
+			continue
+		}
+
+		indent := indentWidth(line[:len(line)-len(trimmed)])
+		for len(stack) > 0 && stack[len(stack)-1].indent > indent {
+			stack = stack[:len(stack)-1]
+		}
+		if len(stack) == 0 || stack[len(stack)-1].indent < indent {
+			stack = append(stack, listLevel{indent: indent})
+		}
+		level := &stack[len(stack)-1]
+
+		var marker string
+		switch {
+		case match != nil && match[1] != "":
+			marker = "- "
+			level.ordinal = 0
+		case preserveNumbering:
+			marker = label + " "
+		case match[2] != "":
+			level.ordinal, _ = strconv.Atoi(match[2])
+			marker = strconv.Itoa(level.ordinal) + ". "
+		default:
+			level.ordinal++
+			marker = strconv.Itoa(level.ordinal) + ". "
+		}
+		level.width = len(marker)
+
+		prefix := 0
+		for _, parent := range stack[:len(stack)-1] {
+			prefix += parent.width
+		}
+		lines[i] = strings.Repeat(" ", prefix) + marker + content
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// indentWidth returns the visual width of leading whitespace, counting tabs as four spaces.
+func indentWidth(whitespace string) int {
+	width := 0
+	for _, r := range whitespace {
+		if r == '\t' {
+			width += 4
+		} else {
+			width++
+		}
+	}
+	return width
+}
@@ -0,0 +1,91 @@
+package kreuzberg
+
+import "testing"
+
+func TestFormatMarkdownLists_Bullets(t *testing.T) {
+	input := "Shopping:\n• apples\n• pears\n* plums"
+	want := "Shopping:\n- apples\n- pears\n- plums"
+
+	if got := formatMarkdownLists(input, false); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatMarkdownLists_NestedProcedure(t *testing.T) {
+	input := "1) Prepare the device\n" +
+		"   a) Unplug the cable\n" +
+		"   b) Remove the cover\n" +
+		"      • keep the screws\n" +
+		"2) Replace the battery\n" +
+		"   i. Insert the new cell\n" +
+		"   ii. Close the cover"
+	want := "1. Prepare the device\n" +
+		"   1. Unplug the cable\n" +
+		"   2. Remove the cover\n" +
+		"      - keep the screws\n" +
+		"2. Replace the battery\n" +
+		"   1. Insert the new cell\n" +
+		"   2. Close the cover"
+
+	if got := formatMarkdownLists(input, false); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatMarkdownLists_NestedWithTabs(t *testing.T) {
+	input := "- top\n\t- child\n\t\t- grandchild\n- next"
+	want := "- top\n  - child\n    - grandchild\n- next"
+
+	if got := formatMarkdownLists(input, false); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestFormatMarkdownLists_LeavesTextAlone(t *testing.T) {
+	input := "A. Smith wrote this in 2024.\n```\n* not a list\n```\n# Heading"
+
+	if got := formatMarkdownLists(input, false); got != input {
+		t.Errorf("expected text to be unchanged, got:\n%s", got)
+	}
+}
+
+func TestFormatMarkdownLists_PreserveNumbering(t *testing.T) {
+	input := "1.2 Definitions\n" +
+		"1.2.3 Term\n" +
+		"4) Obligations\n" +
+		"   c) Payment\n" +
+		"   iv. Delivery\n" +
+		"   • note"
+	want := "1.2 Definitions\n" +
+		"1.2.3 Term\n" +
+		"4) Obligations\n" +
+		"   c) Payment\n" +
+		"   iv. Delivery\n" +
+		"   - note"
+
+	if got := formatMarkdownLists(input, true); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+	if got := formatMarkdownLists("1.2.3 Term", false); got != "1.2.3 Term" {
+		t.Errorf("expected hierarchical numbers to be ignored without preservation, got %q", got)
+	}
+}
+
+func TestFinalizeResult_ListDetectionMarkdownOnly(t *testing.T) {
+	plain := &ExtractionResult{Content: "• item"}
+	if _, err := finalizeResult(plain, NewExtractionConfig(WithListDetection(true))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if plain.Content != "• item" {
+		t.Errorf("expected plain output to be unchanged, got %q", plain.Content)
+	}
+
+	markdown := &ExtractionResult{Content: "• item"}
+	config := NewExtractionConfig(WithListDetection(true), WithOutputFormat("markdown"))
+	if _, err := finalizeResult(markdown, config); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if markdown.Content != "- item" {
+		t.Errorf("expected markdown list item, got %q", markdown.Content)
+	}
+}
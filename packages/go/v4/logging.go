@@ -0,0 +1,92 @@
+package kreuzberg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Native log levels accepted by SetFFILogLevel.
+const (
+	FFILogLevelOff   = "off"
+	FFILogLevelError = "error"
+	FFILogLevelWarn  = "warn"
+	FFILogLevelInfo  = "info"
+	FFILogLevelDebug = "debug"
+)
+
+// rustLogEnv is the environment variable read by the Rust core's logger.
+const rustLogEnv = "RUST_LOG"
+
+// ErrFFILogLevelLocked is the cause of the *RuntimeError returned by
+// SetFFILogLevel once the native logger may have been initialized. Test for it
+// with errors.Is.
+var ErrFFILogLevelLocked = errors.New("kreuzberg: native log level can only be set before the first extraction")
+
+// ffiLog records the native log filter once the first extraction has started.
+var ffiLog struct {
+	mu     sync.Mutex
+	locked bool
+	filter string
+}
+
+// SetFFILogLevel controls the verbosity of diagnostic output emitted by the native
+// layer. Valid levels are "off", "error", "warn", "info", and "debug".
+//
+// Only the verbosity is controlled: the native core has no log callback, so its
+// output cannot be routed through a Go logger such as log/slog and is always
+// written to the process's stderr.
+//
+// The level is applied through RUST_LOG, scoped to the kreuzberg crates, and
+// overrides any value inherited from the environment. The Rust core reads RUST_LOG
+// once, when its logger initializes, and offers no call for changing the level
+// afterwards. SetFFILogLevel must therefore be called during program startup: once
+// the first extraction of the process has started, it returns an error wrapping
+// ErrFFILogLevelLocked and leaves the level unchanged.
+func SetFFILogLevel(level string) error {
+	switch level {
+	case FFILogLevelOff, FFILogLevelError, FFILogLevelWarn, FFILogLevelInfo, FFILogLevelDebug:
+	default:
+		return newValidationErrorWithContext(fmt.Sprintf("invalid FFI log level: %s (valid: off, error, warn, info, debug)", level), nil, ErrorCodeValidation, nil)
+	}
+
+	ffiLog.mu.Lock()
+	defer ffiLog.mu.Unlock()
+	if ffiLog.locked {
+		return newRuntimeErrorWithContext("native logger already initialized", ErrFFILogLevelLocked, ErrorCodeInternal, nil)
+	}
+
+	filter := "kreuzberg=" + level
+	if level == FFILogLevelOff {
+		filter = FFILogLevelOff
+	}
+	if err := os.Setenv(rustLogEnv, filter); err != nil {
+		return newRuntimeErrorWithContext("failed to set native log level", err, ErrorCodeInternal, nil)
+	}
+	return nil
+}
+
+// FFILogLevel returns the native log filter, as set by SetFFILogLevel or inherited
+// from RUST_LOG. Once the first extraction has started, it returns the filter
+// that was in effect at that point, which the native logger keeps for the life of
+// the process. An empty string means the Rust default.
+func FFILogLevel() string {
+	ffiLog.mu.Lock()
+	defer ffiLog.mu.Unlock()
+	if ffiLog.locked {
+		return ffiLog.filter
+	}
+	return os.Getenv(rustLogEnv)
+}
+
+// lockFFILogLevel records the native log filter when the first extraction starts,
+// after which SetFFILogLevel no longer has an effect.
+func lockFFILogLevel() {
+	ffiLog.mu.Lock()
+	defer ffiLog.mu.Unlock()
+	if !ffiLog.locked {
+		ffiLog.locked = true
+		ffiLog.filter = os.Getenv(rustLogEnv)
+	}
+}
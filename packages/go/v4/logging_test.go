@@ -0,0 +1,76 @@
+package kreuzberg
+
+import (
+	"errors"
+	"testing"
+)
+
+// unlockFFILogLevel resets the native log level lock for the duration of t, as
+// earlier tests may already have run extractions.
+func unlockFFILogLevel(t *testing.T) {
+	t.Helper()
+	ffiLog.mu.Lock()
+	locked, filter := ffiLog.locked, ffiLog.filter
+	ffiLog.locked, ffiLog.filter = false, ""
+	ffiLog.mu.Unlock()
+	t.Cleanup(func() {
+		ffiLog.mu.Lock()
+		ffiLog.locked, ffiLog.filter = locked, filter
+		ffiLog.mu.Unlock()
+	})
+}
+
+func TestSetFFILogLevel(t *testing.T) {
+	unlockFFILogLevel(t)
+	t.Setenv(rustLogEnv, "")
+
+	if err := SetFFILogLevel("debug"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := FFILogLevel(); got != "kreuzberg=debug" {
+		t.Errorf("expected kreuzberg=debug, got %q", got)
+	}
+
+	if err := SetFFILogLevel("off"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := FFILogLevel(); got != "off" {
+		t.Errorf("expected off, got %q", got)
+	}
+}
+
+func TestSetFFILogLevelInvalid(t *testing.T) {
+	unlockFFILogLevel(t)
+	t.Setenv(rustLogEnv, "kreuzberg=warn")
+
+	err := SetFFILogLevel("verbose")
+	if err == nil {
+		t.Fatal("expected error for invalid level")
+	}
+	if _, ok := err.(*ValidationError); !ok {
+		t.Fatalf("expected ValidationError, got %T", err)
+	}
+	if got := FFILogLevel(); got != "kreuzberg=warn" {
+		t.Errorf("expected level to be unchanged, got %q", got)
+	}
+}
+
+func TestSetFFILogLevelAfterFirstExtraction(t *testing.T) {
+	unlockFFILogLevel(t)
+	t.Setenv(rustLogEnv, "kreuzberg=warn")
+
+	release, err := beginExtraction()
+	if err != nil {
+		t.Fatalf("beginExtraction: %v", err)
+	}
+	release()
+
+	err = SetFFILogLevel("debug")
+	if !errors.Is(err, ErrFFILogLevelLocked) {
+		t.Fatalf("expected ErrFFILogLevelLocked, got %v", err)
+	}
+	t.Setenv(rustLogEnv, "kreuzberg=debug")
+	if got := FFILogLevel(); got != "kreuzberg=warn" {
+		t.Errorf("expected the level in effect at the first extraction, got %q", got)
+	}
+}
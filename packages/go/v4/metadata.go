@@ -1,6 +1,9 @@
 package kreuzberg
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"sort"
+)
 
 var metadataCoreKeys = map[string]struct{}{
 	"title":               {},
@@ -17,6 +20,7 @@ var metadataCoreKeys = map[string]struct{}{
 	"image_preprocessing": {},
 	"json_schema":         {},
 	"error":               {},
+	"metadata_source":     {},
 }
 
 var formatFieldSets = map[FormatType][]string{
@@ -80,6 +84,7 @@ func (m *Metadata) UnmarshalJSON(data []byte) error {
 	m.ModifiedAt = decodeString("modified_at")
 	m.CreatedBy = decodeString("created_by")
 	m.ModifiedBy = decodeString("modified_by")
+	m.Source = decodeString("metadata_source")
 
 	if value, ok := raw["pages"]; ok {
 		var pages PageStructure
@@ -180,6 +185,9 @@ func (m Metadata) MarshalJSON() ([]byte, error) {
 	if m.Error != nil {
 		out["error"] = m.Error
 	}
+	if m.Source != nil {
+		out["metadata_source"] = *m.Source
+	}
 
 	formatFields, err := m.encodeFormat()
 	if err != nil {
@@ -325,3 +333,74 @@ func encodeStructToRaw(value any) (map[string]json.RawMessage, error) {
 	}
 	return result, nil
 }
+
+// takeAdditional decodes a field that the Rust core reports through the flattened
+// metadata map and removes it from Additional, so it is exposed exactly once.
+func (m *Metadata) takeAdditional(key string, target any) error {
+	raw, ok := m.Additional[key]
+	if !ok {
+		return nil
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return err
+	}
+	delete(m.Additional, key)
+	if len(m.Additional) == 0 {
+		m.Additional = nil
+	}
+	return nil
+}
+
+// promoteMetadataFields moves optional pipeline outputs from Metadata.Additional
+// into their typed ExtractionResult fields.
+func (r *ExtractionResult) promoteMetadataFields() error {
+	fields := []struct {
+		key    string
+		name   string
+		target any
+	}{
+		{"toc", "table of contents", &r.TOC},
+		{"detected_type", "detected document type", &r.DetectedType},
+		{"document_type", "document type", &r.DetectedType},
+		{"document_type_confidence", "document type confidence", &r.DetectedTypeConfidence},
+		{"dates", "extracted dates", &r.Dates},
+		{"dropped_language_sections", "dropped language sections", &r.DroppedLanguageSections},
+		{"annotations", "annotations", &r.Annotations},
+		{"footnotes", "footnotes", &r.Footnotes},
+		{"cross_references", "cross references", &r.CrossReferences},
+		{"confidence_heatmap", "confidence heatmap", &r.ConfidenceHeatmap},
+		{"fonts", "fonts", &r.Fonts},
+		{"pdf_compliance", "PDF compliance", &r.PdfCompliance},
+		{"is_scanned", "scanned classification", &r.IsScanned},
+		{"scanned_confidence", "scanned confidence", &r.ScannedConfidence},
+		{"ocr_regions", "OCR regions", &r.OCRRegions},
+		{"resource_usage", "resource usage", &r.ResourceUsage},
+		{"revisions", "revisions", &r.Revisions},
+		{"metrics", "extraction metrics", &r.Metrics},
+		{"warnings", "warnings", &r.Warnings},
+		{"page_errors", "page errors", &r.PageErrors},
+		{"ocr_fallback", "OCR fallback", &r.OCRFallback},
+		{"language_confidences", "language confidences", &r.LanguageConfidences},
+		{"embedding_cache", "embedding cache statistics", &r.EmbeddingCache},
+		{"extracted_keywords", "extracted keywords", &r.Keywords},
+		{"cache_hit", "cache hit", &r.CacheHit},
+		{"ruby_annotations", "ruby annotations", &r.RubyAnnotations},
+		{"charts", "charts", &r.Charts},
+		{"xmp_packet", "XMP packet", &r.XMPPacket},
+		{"djot_content", "Djot content", &r.DjotContent},
+	}
+
+	for _, field := range fields {
+		if err := r.Metadata.takeAdditional(field.key, field.target); err != nil {
+			return newSerializationErrorWithContext("failed to decode "+field.name, err, ErrorCodeValidation, nil)
+		}
+	}
+	sort.SliceStable(r.LanguageConfidences, func(i, j int) bool {
+		return r.LanguageConfidences[i].Confidence > r.LanguageConfidences[j].Confidence
+	})
+	sort.SliceStable(r.Keywords, func(i, j int) bool {
+		return r.Keywords[i].Score > r.Keywords[j].Score
+	})
+	r.decodeXMP()
+	return nil
+}
@@ -0,0 +1,111 @@
+package kreuzberg
+
+import (
+	"regexp"
+	"strings"
+)
+
+// maxInferredTitleLength bounds the length of a plain-text line accepted as a title.
+const maxInferredTitleLength = 150
+
+// firstPageLineLimit bounds how many lines are scanned when the result has no pages.
+const firstPageLineLimit = 40
+
+// markdownHeadingPattern matches an ATX markdown heading and captures its level and text.
+var markdownHeadingPattern = regexp.MustCompile(`^(#{1,6})[ \t]+(.+?)[ \t#]*$`)
+
+// authorLinePattern matches English and German author lines, e.g. "By Jane Doe",
+// "Author: Jane Doe", or "Von Max Mustermann". The whole line after the prefix
+// must be one or more capitalized names, joined by commas, "and", "und", or "&",
+// so prose such as "By contrast, ..." is not taken for an author line. Names may
+// contain lowercase particles such as "van" or "de".
+var authorLinePattern = regexp.MustCompile(
+	`^(?:(?i:by|von)|(?i:authors?|autor(?:in|en)?|verfasser(?:in)?):)[ \t]+` +
+		`(` + authorNamePattern + `(?:(?:,[ \t]*|[ \t]+(?:and|und|&)[ \t]+)` + authorNamePattern + `)*)$`)
+
+// authorNamePattern matches a personal name: capitalized words or initials,
+// optionally with lowercase name particles between them.
+const authorNamePattern = `\p{Lu}[\p{L}'.-]*(?:[ \t]+(?:(?:van|von|de|der|den|da|di|du|la|le)[ \t]+)*\p{Lu}[\p{L}'.-]*)*`
+
+// inferMetadataFromContent fills missing title, author, and creation date fields
+// from the first page of result. The title is the highest-level markdown heading on
+// the first page, or its first short line for plain text; the date is the first
+// date in result.Dates, which is only populated when date extraction is enabled.
+// Metadata.Source is set to MetadataSourceInferred when any field was filled.
+func inferMetadataFromContent(result *ExtractionResult) {
+	lines := firstPageLines(result)
+	inferred := false
+
+	if result.Metadata.Title == nil {
+		if title := inferTitle(lines); title != "" {
+			result.Metadata.Title = &title
+			inferred = true
+		}
+	}
+	if len(result.Metadata.Authors) == 0 {
+		for _, line := range lines {
+			if match := authorLinePattern.FindStringSubmatch(line); match != nil {
+				result.Metadata.Authors = []string{strings.TrimSpace(match[1])}
+				inferred = true
+				break
+			}
+		}
+	}
+	if result.Metadata.CreatedAt == nil && len(result.Dates) > 0 {
+		created := result.Dates[0].Normalized
+		result.Metadata.CreatedAt = &created
+		inferred = true
+	}
+
+	if inferred {
+		result.Metadata.Source = StringPtr(MetadataSourceInferred)
+	}
+}
+
+// firstPageLines returns the trimmed, non-empty lines of the first page.
+func firstPageLines(result *ExtractionResult) []string {
+	text := result.Content
+	limit := firstPageLineLimit
+	if len(result.Pages) > 0 {
+		text = result.Pages[0].Content
+		limit = -1
+	}
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		lines = append(lines, line)
+		if limit > 0 && len(lines) == limit {
+			break
+		}
+	}
+	return lines
+}
+
+// inferTitle picks the highest-level markdown heading, falling back to the first
+// line that is short enough to be a title and does not read like a sentence.
+func inferTitle(lines []string) string {
+	title := ""
+	bestLevel := 7
+	for _, line := range lines {
+		if match := markdownHeadingPattern.FindStringSubmatch(line); match != nil && len(match[1]) < bestLevel {
+			title = match[2]
+			bestLevel = len(match[1])
+		}
+	}
+	if title != "" {
+		return title
+	}
+
+	if len(lines) == 0 {
+		return ""
+	}
+	first := lines[0]
+	if len(first) > maxInferredTitleLength || strings.HasSuffix(first, ".") || authorLinePattern.MatchString(first) {
+		return ""
+	}
+	return first
+}
@@ -0,0 +1,90 @@
+package kreuzberg
+
+import "testing"
+
+func TestInferMetadataFromContent_Markdown(t *testing.T) {
+	result := &ExtractionResult{
+		Content: "## Abstract\n# Annual Report 2024\nBy Jane Doe\n\nBody text.",
+		Dates:   []ExtractedDate{{Raw: "3 March 2024", Normalized: "2024-03-03T00:00:00Z"}},
+	}
+	inferMetadataFromContent(result)
+
+	if result.Metadata.Title == nil || *result.Metadata.Title != "Annual Report 2024" {
+		t.Errorf("unexpected title: %v", result.Metadata.Title)
+	}
+	if len(result.Metadata.Authors) != 1 || result.Metadata.Authors[0] != "Jane Doe" {
+		t.Errorf("unexpected authors: %v", result.Metadata.Authors)
+	}
+	if result.Metadata.CreatedAt == nil || *result.Metadata.CreatedAt != "2024-03-03T00:00:00Z" {
+		t.Errorf("unexpected created_at: %v", result.Metadata.CreatedAt)
+	}
+	if result.Metadata.Source == nil || *result.Metadata.Source != MetadataSourceInferred {
+		t.Error("expected metadata source to be inferred")
+	}
+}
+
+func TestInferMetadataFromContent_PlainTextFirstPage(t *testing.T) {
+	result := &ExtractionResult{
+		Content: "ignored",
+		Pages: []PageContent{
+			{PageNumber: 1, Content: "\nJahresbericht\nAutor: Max Mustermann\nDies ist der Text."},
+			{PageNumber: 2, Content: "# Later Heading"},
+		},
+	}
+	inferMetadataFromContent(result)
+
+	if result.Metadata.Title == nil || *result.Metadata.Title != "Jahresbericht" {
+		t.Errorf("unexpected title: %v", result.Metadata.Title)
+	}
+	if len(result.Metadata.Authors) != 1 || result.Metadata.Authors[0] != "Max Mustermann" {
+		t.Errorf("unexpected authors: %v", result.Metadata.Authors)
+	}
+}
+
+func TestInferMetadataFromContent_KeepsFileMetadata(t *testing.T) {
+	title := "From File"
+	result := &ExtractionResult{
+		Content:  "# Other Title\nBy Someone",
+		Metadata: Metadata{Title: &title, Authors: []string{"File Author"}},
+	}
+	inferMetadataFromContent(result)
+
+	if *result.Metadata.Title != "From File" || result.Metadata.Authors[0] != "File Author" {
+		t.Error("expected file metadata to be preserved")
+	}
+	if result.Metadata.Source != nil {
+		t.Error("expected no inferred marker when nothing was inferred")
+	}
+}
+
+func TestAuthorLinePattern(t *testing.T) {
+	for line, want := range map[string]string{
+		"By Jane Doe":                      "Jane Doe",
+		"Author: J. R. R. Tolkien":         "J. R. R. Tolkien",
+		"Authors: Jane Doe and John Smith": "Jane Doe and John Smith",
+		"Von Ludwig van Beethoven":         "Ludwig van Beethoven",
+		"Verfasserin: Erika Mustermann":    "Erika Mustermann",
+		"By contrast, the results differ.": "",
+		"by the way":                       "",
+		"Von der Leyen wurde gewählt.":     "",
+	} {
+		got := ""
+		if match := authorLinePattern.FindStringSubmatch(line); match != nil {
+			got = match[1]
+		}
+		if got != want {
+			t.Errorf("%q: expected author %q, got %q", line, want, got)
+		}
+	}
+}
+
+func TestMetadataSourceRoundTrip(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"title": "Guide", "metadata_source": "inferred"}`)
+
+	if result.Metadata.Source == nil || *result.Metadata.Source != MetadataSourceInferred {
+		t.Fatalf("expected inferred source, got %v", result.Metadata.Source)
+	}
+	if result.Metadata.Additional != nil {
+		t.Errorf("expected metadata_source not to be reported as additional, got %v", result.Metadata.Additional)
+	}
+}
@@ -1,6 +1,7 @@
 package kreuzberg
 
 import (
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
@@ -29,6 +30,42 @@ func TestDetectMimeTypeMissingFile(t *testing.T) {
 	}
 }
 
+func TestDetectMimeTypeFileIgnoresExtension(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "report.bin")
+	if err := os.WriteFile(path, []byte("%PDF-1.7\n%"), 0o644); err != nil {
+		t.Fatalf("write temp pdf: %v", err)
+	}
+
+	mime, err := DetectMimeTypeFile(path)
+	if err != nil {
+		t.Fatalf("detect mime: %v", err)
+	}
+	if mime != "application/pdf" {
+		t.Fatalf("expected application/pdf, got %s", mime)
+	}
+}
+
+func TestDetectMimeTypeFileErrors(t *testing.T) {
+	if _, err := DetectMimeTypeFile(""); err == nil {
+		t.Error("expected error for empty path")
+	}
+
+	var ioErr *IOError
+	if _, err := DetectMimeTypeFile(filepath.Join(t.TempDir(), "missing.pdf")); !errors.As(err, &ioErr) {
+		t.Errorf("expected IOError for missing file, got %v", err)
+	}
+
+	empty := filepath.Join(t.TempDir(), "empty.pdf")
+	if err := os.WriteFile(empty, nil, 0o644); err != nil {
+		t.Fatalf("write empty file: %v", err)
+	}
+	var valErr *ValidationError
+	if _, err := DetectMimeTypeFile(empty); !errors.As(err, &valErr) {
+		t.Errorf("expected ValidationError for empty file, got %v", err)
+	}
+}
+
 func TestValidateMimeType(t *testing.T) {
 	mime, err := ValidateMimeType("application/pdf")
 	if err != nil {
@@ -0,0 +1,216 @@
+package kreuzberg
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// numberFormat describes how a locale writes numbers.
+type numberFormat struct {
+	groups  []string
+	decimal string
+}
+
+var (
+	numberFormatPeriodGroups = numberFormat{groups: []string{"."}, decimal: ","}
+	numberFormatSpaceGroups  = numberFormat{groups: []string{" ", " ", " "}, decimal: ","}
+	numberFormatSwiss        = numberFormat{groups: []string{"'", "’"}, decimal: "."}
+)
+
+// numberFormats maps the locales accepted by WithNormalizeNumbers to their number
+// format. Locales are matched on the full tag first, then on the language.
+var numberFormats = map[string]numberFormat{
+	"en":    {groups: []string{","}, decimal: "."},
+	"ja":    {groups: []string{","}, decimal: "."},
+	"zh":    {groups: []string{","}, decimal: "."},
+	"de":    numberFormatPeriodGroups,
+	"es":    numberFormatPeriodGroups,
+	"it":    numberFormatPeriodGroups,
+	"nl":    numberFormatPeriodGroups,
+	"pt":    numberFormatPeriodGroups,
+	"da":    numberFormatPeriodGroups,
+	"id":    numberFormatPeriodGroups,
+	"tr":    numberFormatPeriodGroups,
+	"fr":    numberFormatSpaceGroups,
+	"ru":    numberFormatSpaceGroups,
+	"uk":    numberFormatSpaceGroups,
+	"pl":    numberFormatSpaceGroups,
+	"cs":    numberFormatSpaceGroups,
+	"sk":    numberFormatSpaceGroups,
+	"hu":    numberFormatSpaceGroups,
+	"sv":    numberFormatSpaceGroups,
+	"nb":    numberFormatSpaceGroups,
+	"no":    numberFormatSpaceGroups,
+	"fi":    numberFormatSpaceGroups,
+	"de-ch": numberFormatSwiss,
+	"fr-ch": numberFormatSwiss,
+	"it-ch": numberFormatSwiss,
+	"de-li": numberFormatSwiss,
+}
+
+// lookupNumberFormat returns the number format of a BCP 47 locale such as "de",
+// "de-DE", or "de_CH".
+func lookupNumberFormat(locale string) (numberFormat, bool) {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if format, ok := numberFormats[tag]; ok {
+		return format, true
+	}
+	language, _, _ := strings.Cut(tag, "-")
+	format, ok := numberFormats[language]
+	return format, ok
+}
+
+// ValidateNumberLocale validates a locale for WithNormalizeNumbers, a BCP 47 tag
+// such as "de", "de-DE", or "de-CH". Normalization leaves tokens that continue
+// into more digits or separators, such as dates and IP addresses, untouched. With
+// locales that group digits by spaces, adjacent numbers separated by a single
+// space are read as one number.
+func ValidateNumberLocale(locale string) error {
+	if locale == "" {
+		return newValidationErrorWithContext("number locale cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+	if _, ok := lookupNumberFormat(locale); !ok {
+		supported := make([]string, 0, len(numberFormats))
+		for tag := range numberFormats {
+			supported = append(supported, tag)
+		}
+		sort.Strings(supported)
+		return newValidationErrorWithContext(fmt.Sprintf("unsupported number locale: %s (supported: %s)", locale, strings.Join(supported, ", ")), nil, ErrorCodeValidation, nil)
+	}
+	return nil
+}
+
+// numberNormalizer rewrites the numbers of one locale into canonical form.
+type numberNormalizer struct {
+	format  numberFormat
+	pattern *regexp.Regexp
+}
+
+// newNumberNormalizer returns a normalizer for locale, or nil when the locale is
+// not supported.
+func newNumberNormalizer(locale string) *numberNormalizer {
+	format, ok := lookupNumberFormat(locale)
+	if !ok {
+		return nil
+	}
+	quoted := make([]string, len(format.groups))
+	for i, group := range format.groups {
+		quoted[i] = regexp.QuoteMeta(group)
+	}
+	group := "(?:" + strings.Join(quoted, "|") + ")"
+	decimal := regexp.QuoteMeta(format.decimal)
+	return &numberNormalizer{
+		format:  format,
+		pattern: regexp.MustCompile(`\d{1,3}(?:` + group + `\d{3})+(?:` + decimal + `\d+)?|\d+` + decimal + `\d+`),
+	}
+}
+
+// normalize rewrites every number in text that is written with the locale's
+// group or decimal separators, e.g. "1.234,56" for German, as "1234.56".
+// Candidates that continue into further digits or separators, such as dates,
+// version numbers, and IP addresses, are left untouched.
+func (n *numberNormalizer) normalize(text string) string {
+	matches := n.pattern.FindAllStringIndex(text, -1)
+	if len(matches) == 0 {
+		return text
+	}
+	var b strings.Builder
+	last := 0
+	for _, match := range matches {
+		start, end := match[0], match[1]
+		if !n.isStandalone(text, start, end) {
+			continue
+		}
+		b.WriteString(text[last:start])
+		b.WriteString(n.canonical(text[start:end]))
+		last = end
+	}
+	if last == 0 {
+		return text
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
+
+// canonical removes group separators from number and uses "." as its decimal separator.
+func (n *numberNormalizer) canonical(number string) string {
+	integer, fraction, hasFraction := strings.Cut(number, n.format.decimal)
+	for _, group := range n.format.groups {
+		integer = strings.ReplaceAll(integer, group, "")
+	}
+	if hasFraction {
+		return integer + "." + fraction
+	}
+	return integer
+}
+
+// isStandalone reports whether text[start:end] is a whole number rather than part
+// of a longer token such as "01.02.2023", "10.0.0.1", or "A1.234".
+func (n *numberNormalizer) isStandalone(text string, start, end int) bool {
+	if before, size := utf8.DecodeLastRuneInString(text[:start]); size > 0 {
+		if unicode.IsLetter(before) || unicode.IsDigit(before) {
+			return false
+		}
+		if n.isSeparator(before) {
+			if prev, _ := utf8.DecodeLastRuneInString(text[:start-size]); unicode.IsDigit(prev) {
+				return false
+			}
+		}
+	}
+	if after, size := utf8.DecodeRuneInString(text[end:]); size > 0 {
+		if unicode.IsLetter(after) || unicode.IsDigit(after) {
+			return false
+		}
+		if n.isSeparator(after) || after == '.' || after == ',' {
+			if next, _ := utf8.DecodeRuneInString(text[end+size:]); unicode.IsDigit(next) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isSeparator reports whether r is one of the locale's group or decimal separators.
+func (n *numberNormalizer) isSeparator(r rune) bool {
+	s := string(r)
+	if s == n.format.decimal {
+		return true
+	}
+	for _, group := range n.format.groups {
+		if s == group {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeNumbers rewrites the numbers in the result's content, page texts, and
+// tables from locale into canonical form.
+func normalizeNumbers(result *ExtractionResult, locale string) {
+	n := newNumberNormalizer(locale)
+	if n == nil {
+		return
+	}
+	result.Content = n.normalize(result.Content)
+	n.normalizeTables(result.Tables)
+	for i := range result.Pages {
+		result.Pages[i].Content = n.normalize(result.Pages[i].Content)
+		n.normalizeTables(result.Pages[i].Tables)
+	}
+}
+
+// normalizeTables rewrites the numbers in the cells and markdown of tables.
+func (n *numberNormalizer) normalizeTables(tables []Table) {
+	for i := range tables {
+		tables[i].Markdown = n.normalize(tables[i].Markdown)
+		for _, row := range tables[i].Cells {
+			for j := range row {
+				row[j] = n.normalize(row[j])
+			}
+		}
+	}
+}
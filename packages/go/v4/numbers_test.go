@@ -0,0 +1,62 @@
+package kreuzberg
+
+import "testing"
+
+func TestNumberNormalizer(t *testing.T) {
+	tests := []struct {
+		locale string
+		in     string
+		want   string
+	}{
+		{"de", "Summe: 1.234,56 EUR", "Summe: 1234.56 EUR"},
+		{"de-DE", "1.234.567 Einwohner, Anteil 12,5 %.", "1234567 Einwohner, Anteil 12.5 %."},
+		{"de", "Am 01.02.2023 um 12.30 Uhr", "Am 01.02.2023 um 12.30 Uhr"},
+		{"de", "Server 192.168.100.1, Liste 1,2,3", "Server 192.168.100.1, Liste 1,2,3"},
+		{"en", "Total $1,234,567.89 (3.5%)", "Total $1234567.89 (3.5%)"},
+		{"en", "Version 1.234.5 and A1,234", "Version 1.234.5 and A1,234"},
+		{"fr", "Prix : 1 234,50 €", "Prix : 1234.50 €"},
+		{"fr_FR", "12 345 habitants", "12345 habitants"},
+		{"de-CH", "CHF 1'234.50", "CHF 1234.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+"/"+tt.in, func(t *testing.T) {
+			if got := newNumberNormalizer(tt.locale).normalize(tt.in); got != tt.want {
+				t.Errorf("normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNumberLocale(t *testing.T) {
+	for _, locale := range []string{"de", "de-AT", "pt_BR", "DE-ch"} {
+		if err := ValidateNumberLocale(locale); err != nil {
+			t.Errorf("expected %q to be supported, got %v", locale, err)
+		}
+	}
+	for _, locale := range []string{"", "xx", "klingon"} {
+		if err := ValidateNumberLocale(locale); err == nil {
+			t.Errorf("expected %q to be rejected", locale)
+		}
+	}
+}
+
+func TestFinalizeResult_NormalizeNumbers(t *testing.T) {
+	result := &ExtractionResult{
+		Content: "Umsatz 1.234,56",
+		Tables:  []Table{{Cells: [][]string{{"Betrag"}, {"2.000,00"}}, Markdown: "| 2.000,00 |"}},
+		Pages:   []PageContent{{PageNumber: 1, Content: "Umsatz 1.234,56"}},
+	}
+	if _, err := finalizeResult(result, NewExtractionConfig(WithNormalizeNumbers("de"))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "Umsatz 1234.56" || result.Pages[0].Content != "Umsatz 1234.56" {
+		t.Errorf("unexpected content: %q / %q", result.Content, result.Pages[0].Content)
+	}
+	if result.Tables[0].Cells[1][0] != "2000.00" || result.Tables[0].Markdown != "| 2000.00 |" {
+		t.Errorf("unexpected table: %+v", result.Tables[0])
+	}
+	if err := validateConfigBeforeFFI(NewExtractionConfig(WithNormalizeNumbers("xx"))); err == nil {
+		t.Error("expected unsupported locale to be rejected")
+	}
+}
@@ -0,0 +1,212 @@
+package kreuzberg
+
+import (
+	"context"
+	"io"
+)
+
+// PageResult is one page yielded by a PageIterator.
+type PageResult struct {
+	PageContent
+	// TotalPages is the number of pages in the document.
+	TotalPages uint64 `json:"total_pages"`
+}
+
+// PageIterator yields the pages of a document one at a time. It is created by
+// ExtractFilePages and is not safe for concurrent use.
+type PageIterator struct {
+	ctx         context.Context
+	extractPage func(page uint64) (*ExtractionResult, error)
+	total       uint64
+	next        uint64
+	pending     []PageContent
+	closed      bool
+	progress    ProgressCallback
+}
+
+// ExtractFilePages extracts the document at path page by page:
+//
+//	pages, err := kreuzberg.ExtractFilePages(ctx, "large.pdf", config)
+//	if err != nil {
+//		return err
+//	}
+//	defer pages.Close()
+//	for {
+//		page, err := pages.Next()
+//		if err == io.EOF {
+//			break
+//		}
+//		if err != nil {
+//			return err
+//		}
+//		index(page)
+//	}
+//
+// Each page is extracted on demand when Next is called, with PageConfig.PageNumbers
+// set to that page, so only the current page's text, tables, and images are held
+// in memory. When the native core honors the page selection, later pages are never
+// processed if the loop stops early; otherwise every call extracts the whole
+// document and keeps the requested page. The document is re-opened for every page,
+// so this is slower than ExtractFileSync for documents that fit comfortably in
+// memory. The caller's config is not modified.
+//
+// The per-page extractions do not run the Go-side hooks of config: ContentCallback,
+// ContentValidator, and OnComplete are not called, so that they do not run once
+// per page. If config.ProgressCallback is set, it receives a ProgressStagePage
+// event after each page is extracted, until ctx is done.
+//
+// The first page is extracted before ExtractFilePages returns, so errors opening
+// the document are reported immediately.
+func ExtractFilePages(ctx context.Context, path string, config *ExtractionConfig) (*PageIterator, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if err := validateConfigBeforeFFI(config); err != nil {
+		return nil, err
+	}
+
+	it := &PageIterator{
+		ctx: ctx,
+		extractPage: func(page uint64) (*ExtractionResult, error) {
+			return ExtractFileSync(path, pageExtractionConfig(config, page))
+		},
+		next: 1,
+	}
+	if config != nil {
+		it.progress = progressContextCallback(ctx, config.ProgressCallback)
+	}
+	if err := it.fetch(); err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+// Next returns the next page in page order. It returns io.EOF once every page has
+// been returned, and ctx.Err() when the context passed to ExtractFilePages is done.
+func (it *PageIterator) Next() (*PageResult, error) {
+	if it.closed {
+		return nil, newValidationErrorWithContext("page iterator is closed", nil, ErrorCodeValidation, nil)
+	}
+	if err := it.ctx.Err(); err != nil {
+		return nil, err
+	}
+	for len(it.pending) == 0 {
+		if it.next > it.total {
+			return nil, io.EOF
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+
+	page := it.pending[0]
+	it.pending[0] = PageContent{}
+	it.pending = it.pending[1:]
+	return &PageResult{PageContent: page, TotalPages: it.total}, nil
+}
+
+// Close drops the pages the iterator has extracted but not yet returned, so they
+// can be garbage collected while the iterator is still referenced. No native
+// resources are held between calls to Next. Calling Next after Close returns an
+// error; Close itself is idempotent.
+func (it *PageIterator) Close() error {
+	it.closed = true
+	it.pending = nil
+	it.extractPage = nil
+	return nil
+}
+
+// pageExtractionConfig returns a copy of config that extracts only page, without
+// Go-side hooks.
+func pageExtractionConfig(config *ExtractionConfig, page uint64) *ExtractionConfig {
+	cfg := samplingConfig(config, []uint64{page})
+	cfg.ContentCallback = nil
+	cfg.ContentValidator = nil
+	cfg.OnComplete = nil
+	cfg.ProgressCallback = nil
+	return cfg
+}
+
+// streamContent passes the text of each page of a document to
+// config.ContentCallback as soon as the page is extracted. extract runs one
+// per-page extraction; see ContentCallback.
+func streamContent(ctx context.Context, config *ExtractionConfig, extract func(*ExtractionConfig) (*ExtractionResult, error)) error {
+	it := &PageIterator{
+		ctx: ctx,
+		extractPage: func(page uint64) (*ExtractionResult, error) {
+			return extract(contentStreamConfig(config, page))
+		},
+		next: 1,
+	}
+	defer it.Close()
+	if err := it.fetch(); err != nil {
+		return err
+	}
+	for {
+		page, err := it.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		config.ContentCallback(page.Content, int(page.PageNumber))
+	}
+}
+
+// contentStreamConfig returns the per-page config of streamContent, without the
+// document-level stages that only the full extraction needs.
+func contentStreamConfig(config *ExtractionConfig, page uint64) *ExtractionConfig {
+	cfg := pageExtractionConfig(config, page)
+	cfg.Chunking = nil
+	cfg.Embedding = nil
+	cfg.Keywords = nil
+	cfg.LanguageDetection = nil
+	cfg.DocumentClassification = nil
+	return cfg
+}
+
+// fetch extracts the page it.next and queues the returned pages. Pages before
+// it.next are dropped, so formats that return every page regardless of the page
+// selection are yielded exactly once.
+func (it *PageIterator) fetch() error {
+	result, err := it.extractPage(it.next)
+	if err != nil {
+		return err
+	}
+
+	if result.Metadata.Pages != nil && result.Metadata.Pages.TotalCount > it.total {
+		it.total = result.Metadata.Pages.TotalCount
+	}
+	requested := it.next
+	it.next++
+	if len(result.Pages) == 0 && requested == 1 && it.total <= 1 {
+		// Documents without page structure are yielded as a single page.
+		it.total = 1
+		it.pending = append(it.pending, PageContent{
+			PageNumber: 1,
+			Content:    result.Content,
+			Tables:     result.Tables,
+			Images:     result.Images,
+		})
+		it.reportPage()
+		return nil
+	}
+	for _, page := range result.Pages {
+		if page.PageNumber < requested {
+			continue
+		}
+		it.pending = append(it.pending, page)
+		it.total = max(it.total, page.PageNumber)
+		it.next = max(it.next, page.PageNumber+1)
+	}
+	it.reportPage()
+	return nil
+}
+
+// reportPage reports the pages extracted so far to the progress callback.
+func (it *PageIterator) reportPage() {
+	if it.progress != nil {
+		it.progress(ProgressEvent{Page: int(it.next - 1), TotalPages: int(it.total), Stage: ProgressStagePage})
+	}
+}
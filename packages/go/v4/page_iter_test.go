@@ -0,0 +1,200 @@
+package kreuzberg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func newTestPageIterator(ctx context.Context, extract func(page uint64) (*ExtractionResult, error)) *PageIterator {
+	it := &PageIterator{ctx: ctx, extractPage: extract, next: 1}
+	if err := it.fetch(); err != nil {
+		panic(err)
+	}
+	return it
+}
+
+func pagedResult(total uint64, pages ...uint64) *ExtractionResult {
+	result := &ExtractionResult{Metadata: Metadata{Pages: &PageStructure{TotalCount: total}}}
+	for _, page := range pages {
+		result.Pages = append(result.Pages, PageContent{PageNumber: page})
+	}
+	return result
+}
+
+func collectPages(t *testing.T, it *PageIterator) []uint64 {
+	t.Helper()
+	var pages []uint64
+	for {
+		page, err := it.Next()
+		if err == io.EOF {
+			return pages
+		}
+		if err != nil {
+			t.Fatalf("Next failed: %v", err)
+		}
+		pages = append(pages, page.PageNumber)
+	}
+}
+
+func TestPageIteratorExtractsOnDemand(t *testing.T) {
+	var requested []uint64
+	it := newTestPageIterator(context.Background(), func(page uint64) (*ExtractionResult, error) {
+		requested = append(requested, page)
+		return pagedResult(3, page), nil
+	})
+	defer it.Close()
+
+	page, err := it.Next()
+	if err != nil || page.PageNumber != 1 || page.TotalPages != 3 {
+		t.Fatalf("unexpected first page %+v (%v)", page, err)
+	}
+	if len(requested) != 1 {
+		t.Errorf("expected only page 1 to be extracted, got %v", requested)
+	}
+	if pages := collectPages(t, it); len(pages) != 2 || pages[1] != 3 {
+		t.Errorf("unexpected remaining pages %v", pages)
+	}
+}
+
+func TestPageIteratorWithoutPageSelection(t *testing.T) {
+	calls := 0
+	it := newTestPageIterator(context.Background(), func(uint64) (*ExtractionResult, error) {
+		calls++
+		return pagedResult(3, 1, 2, 3), nil
+	})
+
+	if pages := collectPages(t, it); len(pages) != 3 {
+		t.Errorf("expected 3 pages, got %v", pages)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single extraction, got %d", calls)
+	}
+}
+
+func TestPageIteratorUnpagedDocument(t *testing.T) {
+	it := newTestPageIterator(context.Background(), func(uint64) (*ExtractionResult, error) {
+		return &ExtractionResult{Content: "plain text"}, nil
+	})
+
+	page, err := it.Next()
+	if err != nil || page.Content != "plain text" || page.TotalPages != 1 {
+		t.Fatalf("unexpected page %+v (%v)", page, err)
+	}
+	if _, err := it.Next(); err != io.EOF {
+		t.Errorf("expected io.EOF, got %v", err)
+	}
+}
+
+func TestPageIteratorContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := newTestPageIterator(ctx, func(page uint64) (*ExtractionResult, error) {
+		return pagedResult(5, page), nil
+	})
+
+	cancel()
+	if _, err := it.Next(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestPageIteratorClose(t *testing.T) {
+	it := newTestPageIterator(context.Background(), func(page uint64) (*ExtractionResult, error) {
+		return pagedResult(2, page), nil
+	})
+
+	if err := it.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if _, err := it.Next(); err == nil {
+		t.Error("expected Next to fail after Close")
+	}
+	if err := it.Close(); err != nil {
+		t.Errorf("expected Close to be idempotent, got %v", err)
+	}
+}
+
+func TestExtractFilePagesCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ExtractFilePages(ctx, "document.pdf", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestPageExtractionConfigDropsHooks(t *testing.T) {
+	config := NewExtractionConfig(
+		WithContentCallback(func(string, int) {}),
+		WithContentValidator(func(*ExtractionResult) error { return nil }),
+		WithOnComplete(func(string, *ExtractionResult, error) {}),
+		WithProgressCallback(func(ProgressEvent) {}),
+	)
+
+	cfg := pageExtractionConfig(config, 4)
+	if cfg.ContentCallback != nil || cfg.ContentValidator != nil || cfg.OnComplete != nil || cfg.ProgressCallback != nil {
+		t.Error("expected Go-side hooks to be dropped from the per-page config")
+	}
+	if len(cfg.Pages.PageNumbers) != 1 || cfg.Pages.PageNumbers[0] != 4 {
+		t.Errorf("expected only page 4 to be selected, got %v", cfg.Pages.PageNumbers)
+	}
+	if config.ContentCallback == nil {
+		t.Error("caller config must not be mutated")
+	}
+}
+
+func TestStreamContentDeliversEachPageAsExtracted(t *testing.T) {
+	var events []string
+	config := NewExtractionConfig(WithContentCallback(func(partial string, page int) {
+		events = append(events, "callback "+partial)
+	}))
+	err := streamContent(context.Background(), config, func(cfg *ExtractionConfig) (*ExtractionResult, error) {
+		page := cfg.Pages.PageNumbers[0]
+		events = append(events, "extract "+strconv.FormatUint(page, 10))
+		return &ExtractionResult{
+			Metadata: Metadata{Pages: &PageStructure{TotalCount: 2}},
+			Pages:    []PageContent{{PageNumber: page, Content: "page " + strconv.FormatUint(page, 10)}},
+		}, nil
+	})
+	if err != nil {
+		t.Fatalf("streamContent failed: %v", err)
+	}
+	want := []string{"extract 1", "callback page 1", "extract 2", "callback page 2"}
+	if !slices.Equal(events, want) {
+		t.Errorf("expected %v, got %v", want, events)
+	}
+}
+
+func TestStreamContentUnpagedDocument(t *testing.T) {
+	calls := 0
+	config := NewExtractionConfig(WithContentCallback(func(partial string, page int) {
+		calls++
+		if partial != "plain text" || page != 1 {
+			t.Errorf("unexpected callback arguments: %q, %d", partial, page)
+		}
+	}))
+	err := streamContent(context.Background(), config, func(*ExtractionConfig) (*ExtractionResult, error) {
+		return &ExtractionResult{Content: "plain text"}, nil
+	})
+	if err != nil || calls != 1 {
+		t.Errorf("expected 1 callback, got %d (%v)", calls, err)
+	}
+}
+
+func TestContentStreamConfigSkipsDocumentStages(t *testing.T) {
+	config := NewExtractionConfig(
+		WithContentCallback(func(string, int) {}),
+		WithChunking(WithChunkSize(500)),
+		WithKeywords(WithMaxKeywords(5)),
+	)
+
+	cfg := contentStreamConfig(config, 2)
+	if cfg.ContentCallback != nil || cfg.Chunking != nil || cfg.Keywords != nil {
+		t.Error("expected the callback and document-level stages to be dropped")
+	}
+	if config.ContentCallback == nil || config.Chunking == nil {
+		t.Error("caller config must not be mutated")
+	}
+}
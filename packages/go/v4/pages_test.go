@@ -1,6 +1,7 @@
 package kreuzberg
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
 )
@@ -323,3 +324,58 @@ func TestPageConfigJSONMarshaling(t *testing.T) {
 		t.Fatalf("Config marshaling failed: %v", err)
 	}
 }
+
+// TestPageDetectedDPI tests decoding of per-page detected DPI and low-resolution page lookup.
+func TestPageDetectedDPI(t *testing.T) {
+	var result ExtractionResult
+	payload := `{"content":"","pages":[
+		{"page_number":1,"content":"","detected_dpi":300},
+		{"page_number":2,"content":"","detected_dpi":72},
+		{"page_number":3,"content":""}
+	]}`
+	if err := json.Unmarshal([]byte(payload), &result); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+
+	if result.Pages[0].DetectedDPI == nil || *result.Pages[0].DetectedDPI != 300 {
+		t.Errorf("expected page 1 DPI 300, got %v", result.Pages[0].DetectedDPI)
+	}
+	if result.Pages[2].DetectedDPI != nil {
+		t.Error("expected missing DPI to stay nil")
+	}
+	if low := result.LowResolutionPages(150); len(low) != 1 || low[0] != 2 {
+		t.Errorf("expected page 2 to be low resolution, got %v", low)
+	}
+}
+
+// TestGenerateThumbnails tests the thumbnail config and decoding of page thumbnails.
+func TestGenerateThumbnails(t *testing.T) {
+	config := NewExtractionConfig(WithGenerateThumbnails(72))
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal config: %v", err)
+	}
+	if !strings.Contains(string(data), `"pages":{"extract_pages":true,"thumbnail_dpi":72}`) {
+		t.Errorf("expected thumbnail settings in config JSON, got %s", data)
+	}
+	WithGenerateThumbnails(0)(config)
+	if config.Pages.ThumbnailDPI != nil {
+		t.Error("expected dpi 0 to turn thumbnails off")
+	}
+
+	var result ExtractionResult
+	payload := `{"content":"","pages":[
+		{"page_number":1,"content":"","thumbnail":{"data":"iVBORw==","format":"png","width":60,"height":85}},
+		{"page_number":2,"content":""}
+	]}`
+	if err := json.Unmarshal([]byte(payload), &result); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	thumb := result.Pages[0].Thumbnail
+	if thumb == nil || thumb.Format != "png" || thumb.Width != 60 || thumb.Height != 85 || len(thumb.Data) != 4 {
+		t.Errorf("unexpected thumbnail: %+v", thumb)
+	}
+	if result.Pages[1].Thumbnail != nil {
+		t.Error("expected missing thumbnail to stay nil")
+	}
+}
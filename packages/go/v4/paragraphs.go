@@ -0,0 +1,108 @@
+package kreuzberg
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// mergeParagraphs reports whether config asks to merge paragraphs across pages.
+func mergeParagraphs(config *ExtractionConfig) bool {
+	return config.MergeParagraphsAcrossPages != nil && *config.MergeParagraphsAcrossPages
+}
+
+// pageMarkersInserted reports whether config inserts page markers into Content.
+func pageMarkersInserted(config *ExtractionConfig) bool {
+	return config.Pages != nil && config.Pages.InsertPageMarkers != nil && *config.Pages.InsertPageMarkers
+}
+
+// mergeParagraphsAcrossPages removes the paragraph break between pages from
+// result.Content where the text of a page ends mid-sentence and the next page
+// continues it. Page boundaries are located by finding the page texts in
+// Content; boundaries that cannot be found are left untouched.
+func mergeParagraphsAcrossPages(result *ExtractionResult) {
+	if len(result.Pages) < 2 {
+		return
+	}
+
+	content := result.Content
+	var b strings.Builder
+	offset, merged := 0, 0
+	for i := 0; i+1 < len(result.Pages); i++ {
+		tail := strings.TrimRightFunc(result.Pages[i].Content, unicode.IsSpace)
+		head := strings.TrimLeftFunc(result.Pages[i+1].Content, unicode.IsSpace)
+		if tail == "" || head == "" || !continuesParagraph(tail, head) {
+			continue
+		}
+
+		idx := strings.Index(content[offset:], tail)
+		if idx < 0 {
+			continue
+		}
+		end := offset + idx + len(tail)
+		next := len(content) - len(strings.TrimLeftFunc(content[end:], unicode.IsSpace))
+		if !strings.HasPrefix(content[next:], firstLine(head)) || !strings.Contains(content[end:next], "\n") {
+			continue
+		}
+
+		b.WriteString(content[offset:end])
+		b.WriteString(paragraphJoiner(tail))
+		offset = next
+		merged++
+	}
+	if merged == 0 {
+		return
+	}
+	b.WriteString(content[offset:])
+	result.Content = b.String()
+}
+
+// continuesParagraph reports whether head, the text at the top of a page,
+// continues the paragraph at the end of tail, the text of the previous page:
+// tail must end without sentence punctuation and head must start with a
+// lowercase letter. Headings, list items, tables, block quotes, and code fences
+// on either side mark a genuine boundary.
+func continuesParagraph(tail, head string) bool {
+	if isStructuralLine(lastLine(tail)) || isStructuralLine(firstLine(head)) {
+		return false
+	}
+	last, _ := utf8.DecodeLastRuneInString(tail)
+	first, _ := utf8.DecodeRuneInString(head)
+	if !unicode.IsLower(first) {
+		return false
+	}
+	return unicode.IsLetter(last) || unicode.IsDigit(last) || strings.ContainsRune(",;-–", last)
+}
+
+// isStructuralLine reports whether line is a markdown heading, table row, block
+// quote, code fence, or list item rather than paragraph text.
+func isStructuralLine(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	for _, prefix := range []string{"#", "|", ">", "```", "~~~"} {
+		if strings.HasPrefix(trimmed, prefix) {
+			return true
+		}
+	}
+	return listMarkerPattern.MatchString(trimmed) || multiLevelMarkerPattern.MatchString(trimmed)
+}
+
+// paragraphJoiner returns the text that replaces the page break after tail. A
+// word broken with a hyphen keeps a line break, so that WithHyphenationLanguage
+// can decide whether to join it.
+func paragraphJoiner(tail string) string {
+	if strings.HasSuffix(tail, "-") {
+		return "\n"
+	}
+	return " "
+}
+
+// firstLine returns the first line of text.
+func firstLine(text string) string {
+	line, _, _ := strings.Cut(text, "\n")
+	return line
+}
+
+// lastLine returns the last line of text.
+func lastLine(text string) string {
+	return text[strings.LastIndex(text, "\n")+1:]
+}
@@ -0,0 +1,71 @@
+package kreuzberg
+
+import "testing"
+
+func TestMergeParagraphsAcrossPages(t *testing.T) {
+	pages := []PageContent{
+		{PageNumber: 1, Content: "The committee reviewed the\nproposal in detail and"},
+		{PageNumber: 2, Content: "agreed to fund it.\n\nIt ends here."},
+		{PageNumber: 3, Content: "New section starts here."},
+		{PageNumber: 4, Content: "# Results\n\nnumbers follow"},
+	}
+	result := &ExtractionResult{
+		Content: "The committee reviewed the\nproposal in detail and\n\nagreed to fund it.\n\nIt ends here.\n\nNew section starts here.\n\n# Results\n\nnumbers follow",
+		Pages:   pages,
+	}
+
+	got, err := finalizeResult(result, NewExtractionConfig(WithMergeParagraphsAcrossPages(true)))
+	if err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	want := "The committee reviewed the\nproposal in detail and agreed to fund it.\n\nIt ends here.\n\nNew section starts here.\n\n# Results\n\nnumbers follow"
+	if got.Content != want {
+		t.Errorf("unexpected content:\n%q\nwant:\n%q", got.Content, want)
+	}
+	if got.Pages != nil {
+		t.Error("expected pages extracted for merging to be dropped when not requested")
+	}
+}
+
+func TestMergeParagraphsAcrossPages_HyphenatedWord(t *testing.T) {
+	result := &ExtractionResult{
+		Content: "a carefully bal-\n\nanced budget",
+		Pages:   []PageContent{{Content: "a carefully bal-"}, {Content: "anced budget"}},
+	}
+	got, err := finalizeResult(result, NewExtractionConfig(WithMergeParagraphsAcrossPages(true), WithHyphenationLanguage("en")))
+	if err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if got.Content != "a carefully balanced budget" {
+		t.Errorf("expected hyphenated word to be joined, got %q", got.Content)
+	}
+}
+
+func TestMergeParagraphsAcrossPages_PageMarkers(t *testing.T) {
+	content := "<!-- page 1 -->\nrunning text and\n\n<!-- page 2 -->\nmore text"
+	result := &ExtractionResult{
+		Content: content,
+		Pages:   []PageContent{{Content: "running text and"}, {Content: "more text"}},
+	}
+	config := NewExtractionConfig(
+		WithMergeParagraphsAcrossPages(true),
+		WithPages(WithExtractPages(true), WithInsertPageMarkers(true)),
+	)
+	got, err := finalizeResult(result, config)
+	if err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if got.Content != content {
+		t.Errorf("expected content with page markers to be unchanged, got %q", got.Content)
+	}
+	if len(got.Pages) != 2 {
+		t.Error("expected requested pages to be kept")
+	}
+}
+
+func TestNativeConfig_MergeParagraphsEnablesPages(t *testing.T) {
+	config := NewExtractionConfig(WithMergeParagraphsAcrossPages(true))
+	if native := nativeConfig(config); !pagesRequested(native) {
+		t.Error("expected native config to request page extraction")
+	}
+}
@@ -0,0 +1,574 @@
+package kreuzberg
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// This file contains the pure Go hooks that run around a native extraction call.
+// nativeConfig adjusts the config that is serialized across the FFI boundary, and
+// finalizeResult applies Go-side options to the converted result.
+
+// nativeConfig returns the config that should be sent to the Rust core. It never
+// mutates the caller's config; a shallow copy is returned when adjustments are needed.
+func nativeConfig(config *ExtractionConfig) *ExtractionConfig {
+	if config == nil {
+		return nil
+	}
+	cfg := *config
+	goOnly := clearGoOnlyOptions(&cfg)
+	enablePages := pagesNeeded(config)
+	vertical := verticalOCR(config)
+	if !goOnly && !enablePages && !tablesOnly(config) && !vertical {
+		return config
+	}
+
+	if enablePages {
+		pages := PageConfig{}
+		if config.Pages != nil {
+			pages = *config.Pages
+		}
+		pages.ExtractPages = BoolPtr(true)
+		cfg.Pages = &pages
+	}
+	if tablesOnly(config) {
+		cfg.Chunking = nil
+		cfg.Embedding = nil
+		cfg.Keywords = nil
+		cfg.LanguageDetection = nil
+		cfg.TokenReduction = nil
+	}
+	if vertical {
+		cfg.OCR = verticalOCRConfig(config.OCR)
+	}
+	return &cfg
+}
+
+// clearGoOnlyOptions clears the options of cfg that finalizeResult applies on the
+// Go side, so they are not sent to the native core as unknown keys. It reports
+// whether any of them was set.
+func clearGoOnlyOptions(cfg *ExtractionConfig) bool {
+	set := false
+	for _, field := range []**bool{
+		&cfg.StripEmoji,
+		&cfg.StripSymbols,
+		&cfg.ListDetection,
+		&cfg.PreserveListNumbering,
+		&cfg.MergeParagraphsAcrossPages,
+		&cfg.InferMetadataFromContent,
+		&cfg.RedactImages,
+		&cfg.TablesInContent,
+		&cfg.SplitConcatenatedDocuments,
+		&cfg.ExtractChecksums,
+		&cfg.NormalizeConfusables,
+	} {
+		if *field != nil {
+			*field = nil
+			set = true
+		}
+	}
+	for _, field := range []*string{&cfg.InlineLinkStyle, &cfg.NormalizeNumbers, &cfg.HyphenationLanguage} {
+		if *field != "" {
+			*field = ""
+			set = true
+		}
+	}
+	for _, field := range []**int{&cfg.MaxTablesPerPage, &cfg.MaxContentBytes} {
+		if *field != nil {
+			*field = nil
+			set = true
+		}
+	}
+	if cfg.TruncationMarker != nil {
+		cfg.TruncationMarker = nil
+		set = true
+	}
+	return set
+}
+
+// tablesOnly reports whether the caller asked for tables only.
+func tablesOnly(config *ExtractionConfig) bool {
+	return config.TablesOnly != nil && *config.TablesOnly
+}
+
+// finalizeResult applies Go-side options from config to a result returned by the native core.
+func finalizeResult(result *ExtractionResult, config *ExtractionConfig) (*ExtractionResult, error) {
+	if result == nil {
+		return nil, nil
+	}
+	recordCacheLookup(result, config)
+	if config == nil {
+		// A nil config gets the same defaults as an empty one.
+		config = &ExtractionConfig{}
+	}
+
+	if pagesSelected(config) {
+		selectPages(result, config.Pages.PageNumbers)
+	}
+
+	if tablesOnly(config) {
+		result.Content = ""
+		result.Chunks = nil
+		for i := range result.Pages {
+			result.Pages[i].Content = ""
+		}
+	}
+
+	if len(result.LanguageConfidences) > 1 && !detectMultipleLanguages(config) {
+		result.LanguageConfidences = result.LanguageConfidences[:1]
+	}
+
+	if config.Keywords != nil && len(result.Keywords) > 0 {
+		result.Keywords = limitKeywords(result.Keywords, config.Keywords)
+	}
+
+	if config.Images != nil && config.Images.ExtractImages != nil && !*config.Images.ExtractImages {
+		dropImages(result)
+	}
+
+	if config.Images != nil && config.Images.ExtractInlineImages != nil && !*config.Images.ExtractInlineImages {
+		dropInlineImages(result)
+	}
+
+	if config.RedactImages != nil && *config.RedactImages {
+		redactImages(result)
+	}
+
+	if config.ExtractChecksums != nil && *config.ExtractChecksums {
+		checksumImages(result)
+	}
+
+	if config.TablesInContent != nil && !*config.TablesInContent {
+		removeTablesFromContent(result)
+	}
+
+	limitTablesPerPage(result, maxTablesPerPage(config))
+
+	if config.NormalizeConfusables != nil && *config.NormalizeConfusables {
+		normalizeResultConfusables(result)
+	}
+
+	if mergeParagraphs(config) && !pageMarkersInserted(config) {
+		mergeParagraphsAcrossPages(result)
+	}
+
+	if config.HyphenationLanguage != "" {
+		dehyphenateResult(result, config.HyphenationLanguage)
+	}
+
+	if config.NormalizeNumbers != "" {
+		normalizeNumbers(result, config.NormalizeNumbers)
+	}
+
+	stripEmoji := config.StripEmoji != nil && *config.StripEmoji
+	stripSymbols := config.StripSymbols != nil && *config.StripSymbols
+	if stripEmoji || stripSymbols {
+		stripCharacters(result, stripEmoji, stripSymbols)
+	}
+
+	if config.ListDetection != nil && *config.ListDetection && isMarkdownOutput(config.OutputFormat) {
+		preserveNumbering := config.PreserveListNumbering != nil && *config.PreserveListNumbering
+		result.Content = formatMarkdownLists(result.Content, preserveNumbering)
+		for i := range result.Pages {
+			result.Pages[i].Content = formatMarkdownLists(result.Pages[i].Content, preserveNumbering)
+		}
+	}
+
+	if isMarkdownOutput(config.OutputFormat) && (config.InlineLinkStyle == InlineLinkStyleURLOnly || config.InlineLinkStyle == InlineLinkStyleTextOnly) {
+		result.Content = rewriteMarkdownLinks(result.Content, config.InlineLinkStyle)
+		for i := range result.Pages {
+			result.Pages[i].Content = rewriteMarkdownLinks(result.Pages[i].Content, config.InlineLinkStyle)
+		}
+	}
+
+	if config.MaxContentBytes != nil {
+		truncateContent(result, *config.MaxContentBytes, truncationMarker(config))
+	}
+
+	if config.DateExtraction != nil && *config.DateExtraction && result.Dates == nil {
+		result.Dates = extractDates(result.Content)
+	}
+
+	if config.SplitConcatenatedDocuments != nil && *config.SplitConcatenatedDocuments {
+		result.Children = splitConcatenatedDocuments(result)
+	}
+
+	if config.InferMetadataFromContent != nil && *config.InferMetadataFromContent {
+		inferMetadataFromContent(result)
+	}
+
+	if pagesNeeded(config) {
+		result.Pages = nil
+	}
+
+	if config.ContentValidator != nil {
+		if err := config.ContentValidator(result); err != nil {
+			return nil, newValidationErrorWithContext(fmt.Sprintf("extraction rejected by content validator: %v", err), err, ErrorCodeValidation, nil)
+		}
+	}
+
+	return result, nil
+}
+
+// truncationMarker returns the marker appended to truncated content.
+func truncationMarker(config *ExtractionConfig) string {
+	if config.TruncationMarker != nil {
+		return *config.TruncationMarker
+	}
+	return DefaultTruncationMarker
+}
+
+// truncateContent cuts result.Content to at most maxBytes bytes, including
+// marker, at a character boundary. When marker does not fit in maxBytes, the
+// content is cut without it.
+func truncateContent(result *ExtractionResult, maxBytes int, marker string) {
+	if maxBytes <= 0 || len(result.Content) <= maxBytes {
+		return
+	}
+	if len(marker) > maxBytes {
+		marker = ""
+	}
+	cut := maxBytes - len(marker)
+	for cut > 0 && !utf8.RuneStart(result.Content[cut]) {
+		cut--
+	}
+	result.OriginalContentLength = len(result.Content)
+	result.Truncated = true
+	result.Content = result.Content[:cut] + marker
+}
+
+// batchNotifier reports the outcome of input i of a synchronous batch to
+// config.OnComplete. A nil notifier reports nothing.
+type batchNotifier func(i int, result *ExtractionResult, err error)
+
+// newBatchNotifier returns a notifier that passes name(i) as the path of input i
+// to config.OnComplete, or nil when no callback is set.
+func newBatchNotifier(config *ExtractionConfig, name func(i int) string) batchNotifier {
+	if config == nil || config.OnComplete == nil {
+		return nil
+	}
+	onComplete := config.OnComplete
+	return func(i int, result *ExtractionResult, err error) {
+		onComplete(name(i), result, err)
+	}
+}
+
+// failFrom reports err for inputs from through n-1.
+func (notify batchNotifier) failFrom(from, n int, err error) {
+	if notify == nil {
+		return
+	}
+	for i := from; i < n; i++ {
+		notify(i, nil, err)
+	}
+}
+
+// finalizeBatchResults applies finalizeResult to every result of a batch
+// extraction of n inputs and reports each input to notify as soon as its result
+// is finalized. When a result is rejected, the batch fails and that input and all
+// later ones are reported with the error.
+func finalizeBatchResults(results []*ExtractionResult, n int, config *ExtractionConfig, notify batchNotifier) ([]*ExtractionResult, error) {
+	for i, result := range results {
+		finalized, err := finalizeResult(result, config)
+		if err != nil {
+			notify.failFrom(i, n, err)
+			return nil, err
+		}
+		results[i] = finalized
+		if notify == nil {
+			continue
+		}
+		if finalized == nil {
+			notify(i, nil, newRuntimeErrorWithContext(fmt.Sprintf("no result for batch input %d", i), nil, ErrorCodeInternal, nil))
+			continue
+		}
+		notify(i, finalized, nil)
+	}
+	notify.failFrom(len(results), n, newRuntimeErrorWithContext("no result for batch input", nil, ErrorCodeInternal, nil))
+	return results, nil
+}
+
+// detectMultipleLanguages reports whether the caller asked for every detected
+// language rather than only the top one.
+func detectMultipleLanguages(config *ExtractionConfig) bool {
+	detection := config.LanguageDetection
+	return detection != nil && detection.DetectMultiple != nil && *detection.DetectMultiple
+}
+
+// limitKeywords drops the keywords, sorted by descending score, that fall below
+// config.MinScore or beyond config.MaxKeywords.
+func limitKeywords(keywords []Keyword, config *KeywordConfig) []Keyword {
+	if config.MinScore != nil {
+		for i, keyword := range keywords {
+			if keyword.Score < *config.MinScore {
+				keywords = keywords[:i]
+				break
+			}
+		}
+	}
+	if config.MaxKeywords != nil && *config.MaxKeywords >= 0 && len(keywords) > *config.MaxKeywords {
+		keywords = keywords[:*config.MaxKeywords]
+	}
+	return keywords
+}
+
+// dropImages removes the extracted images from result and its pages.
+func dropImages(result *ExtractionResult) {
+	result.Images = nil
+	for i := range result.Pages {
+		result.Pages[i].Images = nil
+	}
+}
+
+// dropInlineImages removes PDF inline images from result and its pages.
+func dropInlineImages(result *ExtractionResult) {
+	result.Images = withoutInlineImages(result.Images)
+	for i := range result.Pages {
+		result.Pages[i].Images = withoutInlineImages(result.Pages[i].Images)
+	}
+}
+
+// withoutInlineImages returns images without those of kind ImageKindInline.
+func withoutInlineImages(images []ExtractedImage) []ExtractedImage {
+	kept := images[:0]
+	for _, image := range images {
+		if image.Kind != ImageKindInline {
+			kept = append(kept, image)
+		}
+	}
+	return kept
+}
+
+// checksumImages sets the checksum of every image in result and its pages.
+func checksumImages(result *ExtractionResult) {
+	for i := range result.Images {
+		result.Images[i].Checksum = imageChecksum(result.Images[i].Data)
+	}
+	for p := range result.Pages {
+		for i := range result.Pages[p].Images {
+			result.Pages[p].Images[i].Checksum = imageChecksum(result.Pages[p].Images[i].Data)
+		}
+	}
+}
+
+// imageChecksum returns the lowercase hex SHA-256 digest of data.
+func imageChecksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// pagesRequested reports whether the caller explicitly asked for per-page results.
+func pagesRequested(config *ExtractionConfig) bool {
+	return config.Pages != nil && config.Pages.ExtractPages != nil && *config.Pages.ExtractPages
+}
+
+// pagesNeeded reports whether the Go side needs page texts the caller did not
+// request, so they are extracted by the native core and dropped after use.
+func pagesNeeded(config *ExtractionConfig) bool {
+	return (mergeParagraphs(config) || pagesSelected(config)) && !pagesRequested(config)
+}
+
+// pagesSelected reports whether config restricts extraction to
+// PageConfig.PageNumbers.
+func pagesSelected(config *ExtractionConfig) bool {
+	return config.Pages != nil && len(config.Pages.PageNumbers) > 0
+}
+
+// selectPages drops the pages of result that are not in selected. The native core
+// is not required to honor PageConfig.PageNumbers, so the page numbers it returns
+// are checked here.
+func selectPages(result *ExtractionResult, selected []uint64) {
+	dropPages(result, func(page uint64) bool { return !slices.Contains(selected, page) })
+}
+
+// removeTablesFromContent removes the rendered markdown of every table from the
+// result's content and page texts, for cores that render tables inline regardless
+// of TablesInContent.
+func removeTablesFromContent(result *ExtractionResult) {
+	for _, table := range result.Tables {
+		result.Content = removeBlock(result.Content, table.Markdown)
+	}
+	for i := range result.Pages {
+		for _, table := range result.Pages[i].Tables {
+			result.Pages[i].Content = removeBlock(result.Pages[i].Content, table.Markdown)
+		}
+	}
+}
+
+// maxTablesPerPage returns the per-page table limit configured in config.
+func maxTablesPerPage(config *ExtractionConfig) int {
+	if config.MaxTablesPerPage != nil && *config.MaxTablesPerPage > 0 {
+		return *config.MaxTablesPerPage
+	}
+	return DefaultMaxTablesPerPage
+}
+
+// limitTablesPerPage drops all but the limit most confident tables of every page
+// from result.Tables, from the page tables, and from the content and page texts,
+// and records a warning for each page that lost tables. Tables without a page
+// number, as from formats without pages, are never dropped.
+func limitTablesPerPage(result *ExtractionResult, limit int) {
+	dropped := map[int]int{}
+	perPage := map[int][]int{}
+	for i, table := range result.Tables {
+		if table.PageNumber == 0 {
+			continue
+		}
+		perPage[table.PageNumber] = append(perPage[table.PageNumber], i)
+	}
+	drop := map[int]bool{}
+	for page, indices := range perPage {
+		for _, i := range leastConfidentTables(result.Tables, indices, limit) {
+			drop[i] = true
+		}
+		if n := len(indices) - limit; n > 0 {
+			dropped[page] = n
+		}
+	}
+	if len(drop) > 0 {
+		for i := range drop {
+			result.Content = removeBlock(result.Content, result.Tables[i].Markdown)
+		}
+		result.Tables = filterTables(result.Tables, drop)
+	}
+
+	for p := range result.Pages {
+		tables := result.Pages[p].Tables
+		if len(tables) <= limit {
+			continue
+		}
+		indices := make([]int, len(tables))
+		for i := range indices {
+			indices[i] = i
+		}
+		drop := map[int]bool{}
+		for _, i := range leastConfidentTables(tables, indices, limit) {
+			drop[i] = true
+			result.Pages[p].Content = removeBlock(result.Pages[p].Content, tables[i].Markdown)
+		}
+		result.Pages[p].Tables = filterTables(tables, drop)
+		page := int(result.Pages[p].PageNumber)
+		if _, ok := dropped[page]; !ok {
+			dropped[page] = len(tables) - limit
+		}
+	}
+
+	pages := make([]int, 0, len(dropped))
+	for page := range dropped {
+		pages = append(pages, page)
+	}
+	sort.Ints(pages)
+	for _, page := range pages {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("dropped %d tables on page %d (max tables per page: %d)", dropped[page], page, limit))
+	}
+}
+
+// filterTables removes the tables whose index is set in drop, in place.
+func filterTables(tables []Table, drop map[int]bool) []Table {
+	kept := tables[:0]
+	for i, table := range tables {
+		if !drop[i] {
+			kept = append(kept, table)
+		}
+	}
+	return kept
+}
+
+// leastConfidentTables returns the entries of indices, which index tables, that do
+// not rank among the limit most confident tables. Tables without a confidence rank
+// last, and ties keep their document order.
+func leastConfidentTables(tables []Table, indices []int, limit int) []int {
+	if len(indices) <= limit {
+		return nil
+	}
+	ranked := append([]int(nil), indices...)
+	confidence := func(i int) float64 {
+		if tables[i].Confidence == nil {
+			return -1
+		}
+		return *tables[i].Confidence
+	}
+	sort.SliceStable(ranked, func(a, b int) bool {
+		return confidence(ranked[a]) > confidence(ranked[b])
+	})
+	return ranked[limit:]
+}
+
+// removeBlock removes the first occurrence of block from text, joining the text
+// around it with a single blank line.
+func removeBlock(text, block string) string {
+	block = strings.TrimSpace(block)
+	if block == "" {
+		return text
+	}
+	idx := strings.Index(text, block)
+	if idx < 0 {
+		return text
+	}
+	before := strings.TrimRight(text[:idx], " \t\n")
+	after := strings.TrimLeft(text[idx+len(block):], "\n")
+	if before == "" || after == "" {
+		return before + after
+	}
+	return before + "\n\n" + after
+}
+
+// emojiTable covers the pictographic blocks used for emoji.
+var emojiTable = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2300, Hi: 0x23ff, Stride: 1}, // Miscellaneous Technical
+		{Lo: 0x25a0, Hi: 0x27bf, Stride: 1}, // Geometric Shapes, Miscellaneous Symbols, Dingbats
+		{Lo: 0x2b00, Hi: 0x2bff, Stride: 1}, // Miscellaneous Symbols and Arrows
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1f000, Hi: 0x1faff, Stride: 1}, // Mahjong Tiles through Symbols and Pictographs Extended-A
+	},
+}
+
+// isEmojiModifier reports whether r only has meaning as part of an emoji sequence:
+// variation selectors, the zero width joiner, the combining keycap, and tag characters.
+func isEmojiModifier(r rune) bool {
+	return r == 0xfe0e || r == 0xfe0f || r == 0x200d || r == 0x20e3 || (r >= 0xe0020 && r <= 0xe007f)
+}
+
+// stripCharacters removes emoji and/or symbol characters from the result's content
+// and page texts, recording the number of characters removed from Content.
+func stripCharacters(result *ExtractionResult, emoji, symbols bool) {
+	var removed int
+	result.Content, removed = stripRunes(result.Content, emoji, symbols)
+	result.StrippedCharCount += removed
+	for i := range result.Pages {
+		result.Pages[i].Content, _ = stripRunes(result.Pages[i].Content, emoji, symbols)
+	}
+}
+
+// stripRunes returns text without the selected characters and the number of
+// characters removed. Emoji modifiers are only removed when they follow a removed
+// character, so joiners inside non-emoji text (e.g. Indic scripts) are preserved.
+func stripRunes(text string, emoji, symbols bool) (string, int) {
+	var b strings.Builder
+	b.Grow(len(text))
+	removed := 0
+	prevRemoved := false
+	for _, r := range text {
+		drop := (emoji && unicode.Is(emojiTable, r)) ||
+			(symbols && unicode.IsSymbol(r)) ||
+			(prevRemoved && isEmojiModifier(r))
+		if drop {
+			removed++
+		} else {
+			b.WriteRune(r)
+		}
+		prevRemoved = drop
+	}
+	if removed == 0 {
+		return text, 0
+	}
+	return b.String(), removed
+}
@@ -0,0 +1,471 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestNativeConfig_TablesOnlySkipsTextStages(t *testing.T) {
+	config := NewExtractionConfig(
+		WithTablesOnly(true),
+		WithChunking(WithChunkSize(500)),
+		WithKeywords(WithMaxKeywords(5)),
+		WithLanguageDetection(WithLanguageDetectionEnabled(true)),
+		WithTokenReduction(WithTokenReductionMode("light")),
+	)
+
+	native := nativeConfig(config)
+	if native.Chunking != nil || native.Keywords != nil || native.LanguageDetection != nil || native.TokenReduction != nil {
+		t.Errorf("expected text stages to be dropped from the native config, got %+v", native)
+	}
+	if native.TablesOnly == nil || !*native.TablesOnly {
+		t.Error("expected the native core to be asked for tables only")
+	}
+	if config.Chunking == nil || config.Keywords == nil {
+		t.Error("caller config must not be mutated")
+	}
+
+	result := &ExtractionResult{
+		Content: "narrative | a |",
+		Tables:  []Table{{Cells: [][]string{{"a"}}}},
+		Chunks:  []Chunk{{Content: "narrative"}},
+		Pages:   []PageContent{{PageNumber: 1, Content: "narrative"}},
+	}
+	if _, err := finalizeResult(result, config); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "" || result.Chunks != nil || result.Pages[0].Content != "" {
+		t.Errorf("expected text to be left empty, got %+v", result)
+	}
+	if len(result.Tables) != 1 {
+		t.Error("expected tables to be kept")
+	}
+}
+
+func TestNativeConfig_Unchanged(t *testing.T) {
+	config := NewExtractionConfig(WithUseCache(false))
+	if nativeConfig(config) != config {
+		t.Error("expected config without Go-side hooks to be passed through")
+	}
+	if nativeConfig(nil) != nil {
+		t.Error("expected nil config to stay nil")
+	}
+}
+
+func TestNativeConfig_StripsGoOnlyOptions(t *testing.T) {
+	config := NewExtractionConfig(
+		WithUseCache(false),
+		WithStripEmoji(true),
+		WithListDetection(true),
+		WithNormalizeNumbers("de"),
+		WithTablesInContent(false),
+		WithInlineLinkStyle(InlineLinkStyleURLOnly),
+		WithHyphenationLanguage("en"),
+		WithMergeParagraphsAcrossPages(true),
+		WithMaxTablesPerPage(5),
+	)
+
+	data, err := json.Marshal(nativeConfig(config))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	for _, key := range []string{"strip_emoji", "list_detection", "normalize_numbers", "tables_in_content", "inline_link_style", "hyphenation_language", "merge_paragraphs_across_pages", "max_tables_per_page"} {
+		if strings.Contains(string(data), `"`+key+`"`) {
+			t.Errorf("expected Go-only option %s not to be sent to the native core: %s", key, data)
+		}
+	}
+	if !strings.Contains(string(data), `"use_cache":false`) {
+		t.Errorf("expected native options to be kept: %s", data)
+	}
+	if config.StripEmoji == nil || config.NormalizeNumbers != "de" {
+		t.Error("caller config must not be mutated")
+	}
+}
+
+func TestFinalizeResult_ContentValidator(t *testing.T) {
+	errTooShort := errors.New("content too short")
+	config := NewExtractionConfig(WithContentValidator(func(result *ExtractionResult) error {
+		if len(result.Content) < 10 {
+			return errTooShort
+		}
+		return nil
+	}))
+
+	got, err := finalizeResult(&ExtractionResult{Content: "long enough content"}, config)
+	if err != nil || got == nil {
+		t.Fatalf("expected accepted result, got %v, %v", got, err)
+	}
+
+	got, err = finalizeResult(&ExtractionResult{Content: "short"}, config)
+	if got != nil {
+		t.Error("expected no result when the validator rejects it")
+	}
+	if !errors.Is(err, errTooShort) {
+		t.Errorf("expected validator error to be wrapped, got %v", err)
+	}
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Errorf("expected ValidationError, got %T", err)
+	}
+
+	if _, err := finalizeBatchResults([]*ExtractionResult{{Content: "long enough content"}, {Content: "short"}}, 2, config, nil); !errors.Is(err, errTooShort) {
+		t.Errorf("expected batch to fail with validator error, got %v", err)
+	}
+}
+
+func TestFinalizeResult_TablesInContent(t *testing.T) {
+	table := "| a | b |\n|---|---|\n| 1 | 2 |"
+	newResult := func() *ExtractionResult {
+		return &ExtractionResult{
+			Content: "Intro\n\n" + table + "\n\n  Outro",
+			Tables:  []Table{{Markdown: table + "\n"}},
+			Pages:   []PageContent{{PageNumber: 1, Content: table + "\nOutro", Tables: []Table{{Markdown: table}}}},
+		}
+	}
+
+	kept := newResult()
+	if _, err := finalizeResult(kept, NewExtractionConfig(WithTablesInContent(true))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if kept.Content != newResult().Content {
+		t.Errorf("expected content to be unchanged, got %q", kept.Content)
+	}
+
+	result := newResult()
+	if _, err := finalizeResult(result, NewExtractionConfig(WithTablesInContent(false))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "Intro\n\n  Outro" {
+		t.Errorf("unexpected content: %q", result.Content)
+	}
+	if result.Pages[0].Content != "Outro" {
+		t.Errorf("unexpected page content: %q", result.Pages[0].Content)
+	}
+	if len(result.Tables) != 1 {
+		t.Error("expected structured tables to be kept")
+	}
+}
+
+func TestFinalizeResult_ImagesDisabled(t *testing.T) {
+	newResult := func() *ExtractionResult {
+		return &ExtractionResult{
+			Images: []ExtractedImage{{Format: "png"}},
+			Pages:  []PageContent{{PageNumber: 1, Images: []ExtractedImage{{Format: "png"}}}},
+		}
+	}
+
+	result := newResult()
+	if _, err := finalizeResult(result, NewExtractionConfig(WithImages(WithExtractImages(false)))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Images != nil || result.Pages[0].Images != nil {
+		t.Errorf("expected images to be omitted, got %+v", result)
+	}
+
+	result = newResult()
+	if _, err := finalizeResult(result, NewExtractionConfig(WithImages(WithExtractImages(true)))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.Images) != 1 || len(result.Pages[0].Images) != 1 {
+		t.Errorf("expected images to be kept, got %+v", result)
+	}
+}
+
+func TestFinalizeResult_StripEmoji(t *testing.T) {
+	config := NewExtractionConfig(WithStripEmoji(true))
+	result := &ExtractionResult{
+		Content: "Great day 👍🏽 at the café ❤️ – cost $5",
+		Pages:   []PageContent{{PageNumber: 1, Content: "👨‍👩‍👧 family"}},
+	}
+
+	if _, err := finalizeResult(result, config); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "Great day  at the café  – cost $5" {
+		t.Errorf("unexpected content: %q", result.Content)
+	}
+	if result.StrippedCharCount != 4 {
+		t.Errorf("expected 4 stripped characters, got %d", result.StrippedCharCount)
+	}
+	if result.Pages[0].Content != " family" {
+		t.Errorf("unexpected page content: %q", result.Pages[0].Content)
+	}
+}
+
+func TestFinalizeResult_StripSymbols(t *testing.T) {
+	config := NewExtractionConfig(WithStripSymbols(true))
+	result := &ExtractionResult{Content: "Price: $5 + €3 © नमस्ते"}
+
+	if _, err := finalizeResult(result, config); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "Price: 5  3  नमस्ते" {
+		t.Errorf("unexpected content: %q", result.Content)
+	}
+	if result.StrippedCharCount != 4 {
+		t.Errorf("expected 4 stripped characters, got %d", result.StrippedCharCount)
+	}
+}
+
+func TestStripRunes_PreservesJoinersInText(t *testing.T) {
+	text := "क्‍ष"
+	got, removed := stripRunes(text, true, true)
+	if got != text || removed != 0 {
+		t.Errorf("expected text to be unchanged, got %q (%d removed)", got, removed)
+	}
+}
+
+func TestFinalizeBatchResults(t *testing.T) {
+	config := NewExtractionConfig(WithStripEmoji(true))
+	results := []*ExtractionResult{{Content: "a 🎉"}, nil, {Content: "b"}}
+
+	got, err := finalizeBatchResults(results, len(results), config, nil)
+	if err != nil {
+		t.Fatalf("finalizeBatchResults failed: %v", err)
+	}
+	if got[0].Content != "a " || got[1] != nil || got[2].Content != "b" {
+		t.Errorf("unexpected batch results: %q, %v, %q", got[0].Content, got[1], got[2].Content)
+	}
+}
+
+func TestFinalizeBatchResults_NotifiesEachInput(t *testing.T) {
+	var names []string
+	var outcomes []error
+	config := NewExtractionConfig(WithOnComplete(func(path string, result *ExtractionResult, err error) {
+		if (result == nil) == (err == nil) {
+			t.Errorf("%s: expected exactly one of result and error, got %v, %v", path, result, err)
+		}
+		names = append(names, path)
+		outcomes = append(outcomes, err)
+	}))
+	notify := newBatchNotifier(config, strconv.Itoa)
+
+	batch := []*ExtractionResult{{Content: "a"}, nil}
+	if _, err := finalizeBatchResults(batch, 3, config, notify); err != nil {
+		t.Fatalf("finalizeBatchResults failed: %v", err)
+	}
+	if !reflect.DeepEqual(names, []string{"0", "1", "2"}) {
+		t.Fatalf("expected one callback per input in input order, got %v", names)
+	}
+	if outcomes[0] != nil || outcomes[1] == nil || outcomes[2] == nil {
+		t.Errorf("expected inputs without a result to fail, got %v", outcomes)
+	}
+}
+
+func TestFinalizeBatchResults_RejectedInput(t *testing.T) {
+	errRejected := errors.New("rejected")
+	var outcomes []error
+	config := NewExtractionConfig(
+		WithContentValidator(func(result *ExtractionResult) error {
+			if result.Content == "bad" {
+				return errRejected
+			}
+			return nil
+		}),
+		WithOnComplete(func(_ string, _ *ExtractionResult, err error) { outcomes = append(outcomes, err) }),
+	)
+	notify := newBatchNotifier(config, strconv.Itoa)
+
+	batch := []*ExtractionResult{{Content: "good"}, {Content: "bad"}, {Content: "good"}}
+	if _, err := finalizeBatchResults(batch, len(batch), config, notify); !errors.Is(err, errRejected) {
+		t.Fatalf("expected batch to fail with the rejection, got %v", err)
+	}
+	if len(outcomes) != 3 || outcomes[0] != nil || !errors.Is(outcomes[1], errRejected) || !errors.Is(outcomes[2], errRejected) {
+		t.Errorf("expected the rejected input and later ones to report the error, got %v", outcomes)
+	}
+}
+
+func TestFinalizeResult_MaxTablesPerPage(t *testing.T) {
+	confidence := func(v float64) *float64 { return &v }
+	result := &ExtractionResult{
+		Content: "a\n\nb\n\nd\n\ne\n\nc",
+		Tables: []Table{
+			{Markdown: "a", PageNumber: 1, Confidence: confidence(0.2)},
+			{Markdown: "b", PageNumber: 1, Confidence: confidence(0.9)},
+			{Markdown: "c", PageNumber: 2},
+			{Markdown: "d", PageNumber: 1},
+			{Markdown: "e", PageNumber: 1, Confidence: confidence(0.5)},
+		},
+		Pages: []PageContent{{
+			PageNumber: 1,
+			Content:    "a\n\nb\n\nd\n\ne",
+			Tables: []Table{
+				{Markdown: "a", PageNumber: 1, Confidence: confidence(0.2)},
+				{Markdown: "b", PageNumber: 1, Confidence: confidence(0.9)},
+				{Markdown: "d", PageNumber: 1},
+				{Markdown: "e", PageNumber: 1, Confidence: confidence(0.5)},
+			},
+		}},
+	}
+
+	got, err := finalizeResult(result, NewExtractionConfig(WithMaxTablesPerPage(2)))
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+
+	markdown := func(tables []Table) []string {
+		out := make([]string, 0, len(tables))
+		for _, table := range tables {
+			out = append(out, table.Markdown)
+		}
+		return out
+	}
+	if want := []string{"b", "c", "e"}; !reflect.DeepEqual(markdown(got.Tables), want) {
+		t.Errorf("tables = %v, want %v", markdown(got.Tables), want)
+	}
+	if want := []string{"b", "e"}; !reflect.DeepEqual(markdown(got.Pages[0].Tables), want) {
+		t.Errorf("page tables = %v, want %v", markdown(got.Pages[0].Tables), want)
+	}
+	if want := []string{"dropped 2 tables on page 1 (max tables per page: 2)"}; !reflect.DeepEqual(got.Warnings, want) {
+		t.Errorf("warnings = %v, want %v", got.Warnings, want)
+	}
+	if want := "b\n\ne\n\nc"; got.Content != want {
+		t.Errorf("content = %q, want %q", got.Content, want)
+	}
+	if want := "b\n\ne"; got.Pages[0].Content != want {
+		t.Errorf("page content = %q, want %q", got.Pages[0].Content, want)
+	}
+
+	if err := NewExtractionConfig(WithMaxTablesPerPage(0)).Validate(); err == nil {
+		t.Error("expected error for non-positive max tables per page")
+	}
+}
+
+func TestFinalizeResult_MaxTablesPerPageDefault(t *testing.T) {
+	tables := func(n int, page int) []Table {
+		out := make([]Table, n)
+		for i := range out {
+			out[i].PageNumber = page
+		}
+		return out
+	}
+
+	result := &ExtractionResult{Tables: tables(DefaultMaxTablesPerPage, 1)}
+	got, err := finalizeResult(result, &ExtractionConfig{})
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if len(got.Tables) != DefaultMaxTablesPerPage || len(got.Warnings) != 0 {
+		t.Errorf("expected %d tables and no warnings, got %d tables and %v", DefaultMaxTablesPerPage, len(got.Tables), got.Warnings)
+	}
+
+	for _, config := range []*ExtractionConfig{nil, {}} {
+		got, err := finalizeResult(&ExtractionResult{Tables: tables(DefaultMaxTablesPerPage+1, 1)}, config)
+		if err != nil {
+			t.Fatalf("finalizeResult: %v", err)
+		}
+		if len(got.Tables) != DefaultMaxTablesPerPage {
+			t.Errorf("config %v: expected the default cap, got %d tables", config, len(got.Tables))
+		}
+	}
+
+	got, err = finalizeResult(&ExtractionResult{Tables: tables(DefaultMaxTablesPerPage+1, 0)}, nil)
+	if err != nil {
+		t.Fatalf("finalizeResult: %v", err)
+	}
+	if len(got.Tables) != DefaultMaxTablesPerPage+1 || len(got.Warnings) != 0 {
+		t.Errorf("expected tables without a page number to be kept, got %d tables and %v", len(got.Tables), got.Warnings)
+	}
+}
+
+func TestNativeConfig_VerticalText(t *testing.T) {
+	config := NewExtractionConfig(
+		WithVerticalText(true),
+		WithOCR(WithOCRLanguage("jpn+eng")),
+	)
+
+	native := nativeConfig(config)
+	if native == config {
+		t.Fatal("expected a copy of the config")
+	}
+	if got := *native.OCR.Language; got != "jpn_vert+eng" {
+		t.Errorf("expected vertical language model, got %q", got)
+	}
+	if native.OCR.Tesseract == nil || native.OCR.Tesseract.PSM == nil || *native.OCR.Tesseract.PSM != 5 {
+		t.Errorf("expected vertical page segmentation mode, got %+v", native.OCR.Tesseract)
+	}
+	if *config.OCR.Language != "jpn+eng" || config.OCR.Tesseract != nil {
+		t.Error("caller's OCR config was modified")
+	}
+
+	psm := 6
+	config.OCR.Tesseract = &TesseractConfig{PSM: &psm}
+	if got := *nativeConfig(config).OCR.Tesseract.PSM; got != 6 {
+		t.Errorf("expected explicit PSM to be kept, got %d", got)
+	}
+
+	paddle := NewExtractionConfig(WithVerticalText(true), WithOCR(WithOCRBackend(string(OCRBackendPaddleOCR))))
+	if nativeConfig(paddle) != paddle {
+		t.Error("expected non-Tesseract OCR configs to be sent unchanged")
+	}
+}
+
+func TestFinalizeResult_MaxContentBytes(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    []ExtractionOption
+		content string
+		want    string
+	}{
+		{"default marker", []ExtractionOption{WithMaxContentBytes(20)}, "The quick brown fox jumps", "The qu" + DefaultTruncationMarker},
+		{"custom marker", []ExtractionOption{WithMaxContentBytes(13), WithTruncationMarker(" …")}, "The quick brown fox", "The quick" + " …"},
+		{"no marker", []ExtractionOption{WithMaxContentBytes(6), WithTruncationMarker("")}, "naïve text", "naïve"},
+		{"character boundary", []ExtractionOption{WithMaxContentBytes(3), WithTruncationMarker("")}, "naïve", "na"},
+		{"marker too long", []ExtractionOption{WithMaxContentBytes(5)}, "The quick brown fox", "The q"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := finalizeResult(&ExtractionResult{Content: tt.content}, NewExtractionConfig(tt.opts...))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result.Content != tt.want {
+				t.Errorf("Content = %q, want %q", result.Content, tt.want)
+			}
+			if !result.Truncated || result.OriginalContentLength != len(tt.content) {
+				t.Errorf("expected truncation of %d bytes to be reported, got Truncated=%v OriginalContentLength=%d", len(tt.content), result.Truncated, result.OriginalContentLength)
+			}
+		})
+	}
+
+	result, err := finalizeResult(&ExtractionResult{Content: "short"}, NewExtractionConfig(WithMaxContentBytes(20)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Content != "short" || result.Truncated || result.OriginalContentLength != 0 {
+		t.Errorf("expected content within the limit to be unchanged, got %+v", result)
+	}
+
+	if err := NewExtractionConfig(WithMaxContentBytes(0)).Validate(); err == nil {
+		t.Error("expected error for non-positive max content bytes")
+	}
+}
+
+func TestFinalizeResult_PageNumbers(t *testing.T) {
+	config := NewExtractionConfig(WithFirstPageOnly(true))
+	native := nativeConfig(config)
+	if !pagesRequested(native) {
+		t.Error("expected pages to be extracted so the selection can be checked")
+	}
+
+	two := uint64(2)
+	result := &ExtractionResult{
+		Content: "one\n\ntwo",
+		Pages:   []PageContent{{PageNumber: 1, Content: "one"}, {PageNumber: 2, Content: "two"}},
+		Tables:  []Table{{PageNumber: 1}, {PageNumber: 2}},
+		Images:  []ExtractedImage{{PageNumber: &two}},
+	}
+	if _, err := finalizeResult(result, config); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "one" || len(result.Tables) != 1 || len(result.Images) != 0 {
+		t.Errorf("expected only page 1 to be kept, got %+v", result)
+	}
+	if result.Pages != nil {
+		t.Error("expected pages the caller did not request to be dropped")
+	}
+}
@@ -33,6 +33,22 @@ func TestRegisterOCRBackend(t *testing.T) {
 	}
 }
 
+func TestRegisteredOCRBackendPassesValidation(t *testing.T) {
+	name := fmt.Sprintf("go-ocr-%d", time.Now().UnixNano())
+	if err := RegisterOCRBackend(name, testOcrBackendCallback); err != nil {
+		t.Fatalf("register ocr backend: %v", err)
+	}
+	defer func() { _ = UnregisterOCRBackend(name) }()
+
+	config := NewExtractionConfig(WithOCR(WithOCRBackend(name), WithOCRBackends(name)))
+	if err := validateConfigBeforeFFI(config); err != nil {
+		t.Errorf("expected registered backend to pass the pre-flight check, got %v", err)
+	}
+	if err := config.Validate(); err != nil {
+		t.Errorf("expected registered backend to pass Validate, got %v", err)
+	}
+}
+
 func TestRegisterValidatorGuards(t *testing.T) {
 	if err := RegisterValidator("", 0, nil); err == nil {
 		t.Fatalf("expected validation error for empty name")
@@ -0,0 +1,190 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// profileFormat is the layout version of profile files written by SaveProfile.
+const profileFormat = 1
+
+// profileExt is the file extension of profile files.
+const profileExt = ".json"
+
+// profileFile is the on-disk form of a profile.
+type profileFile struct {
+	Format      int               `json:"format"`
+	Name        string            `json:"name"`
+	Version     int               `json:"version"`
+	SavedAt     time.Time         `json:"saved_at"`
+	Fingerprint string            `json:"fingerprint"`
+	Config      *ExtractionConfig `json:"config"`
+}
+
+// SaveProfile validates config and stores it in dir as the profile name, so
+// services can share standardized extraction settings by name:
+//
+//	err := kreuzberg.SaveProfile("invoices", config, "/etc/kreuzberg/profiles")
+//	...
+//	config, err := kreuzberg.LoadProfile("invoices", "/etc/kreuzberg/profiles")
+//
+// Each profile is a JSON file named after the profile, stamped with a version
+// that starts at 1 and increases every time the profile is saved again, the save
+// time, and the config's Fingerprint. dir is created when needed, and the file is
+// replaced atomically so concurrent readers never see a partial profile. Names
+// may contain letters, digits, '-', '_', and '.', and must not start with '.'.
+// Go-side hooks such as ContentCallback are not serialized and are not part of a
+// profile.
+func SaveProfile(name string, config *ExtractionConfig, dir string) error {
+	if err := validateProfileName(name); err != nil {
+		return err
+	}
+	if config == nil {
+		return newValidationErrorWithContext("config cannot be nil", nil, ErrorCodeValidation, nil)
+	}
+	if dir == "" {
+		return newValidationErrorWithContext("profile directory cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	version := 1
+	if previous, err := readProfile(name, dir); err == nil {
+		version = previous.Version + 1
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	data, err := json.MarshalIndent(profileFile{
+		Format:      profileFormat,
+		Name:        name,
+		Version:     version,
+		SavedAt:     time.Now().UTC(),
+		Fingerprint: config.Fingerprint(),
+		Config:      config,
+	}, "", "  ")
+	if err != nil {
+		return newSerializationErrorWithContext("failed to encode profile", err, ErrorCodeValidation, nil)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return newIOErrorWithContext("failed to create profile directory", err, ErrorCodeIo, nil)
+	}
+	tmp, err := os.CreateTemp(dir, "."+name+"-*")
+	if err != nil {
+		return newIOErrorWithContext("failed to create profile file", err, ErrorCodeIo, nil)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		_ = tmp.Close()
+		return newIOErrorWithContext("failed to write profile file", err, ErrorCodeIo, nil)
+	}
+	if err := tmp.Close(); err != nil {
+		return newIOErrorWithContext("failed to close profile file", err, ErrorCodeIo, nil)
+	}
+	if err := os.Rename(tmp.Name(), profilePath(name, dir)); err != nil {
+		return newIOErrorWithContext("failed to replace profile file", err, ErrorCodeIo, nil)
+	}
+	return nil
+}
+
+// LoadProfile reads the profile name saved in dir by SaveProfile and returns its
+// config after checking it with ExtractionConfig.Validate. Profiles written by a
+// newer, incompatible layout are rejected with a *ValidationError.
+func LoadProfile(name string, dir string) (*ExtractionConfig, error) {
+	if err := validateProfileName(name); err != nil {
+		return nil, err
+	}
+	profile, err := readProfile(name, dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, newValidationErrorWithContext(fmt.Sprintf("profile %q not found in %s", name, dir), err, ErrorCodeValidation, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := profile.Config.Validate(); err != nil {
+		return nil, err
+	}
+	return profile.Config, nil
+}
+
+// ListProfiles returns the names of the profiles saved in dir, sorted. A
+// directory that does not exist holds no profiles.
+func ListProfiles(dir string) ([]string, error) {
+	if dir == "" {
+		return nil, newValidationErrorWithContext("profile directory cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, newIOErrorWithContext("failed to read profile directory", err, ErrorCodeIo, nil)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), profileExt)
+		if !ok || !entry.Type().IsRegular() || validateProfileName(name) != nil {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// readProfile decodes the profile file of name in dir. A missing file is
+// returned as an error wrapping os.ErrNotExist.
+func readProfile(name, dir string) (*profileFile, error) {
+	data, err := os.ReadFile(profilePath(name, dir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, newIOErrorWithContext("failed to read profile file", err, ErrorCodeIo, nil)
+	}
+
+	var profile profileFile
+	if err := json.Unmarshal(data, &profile); err != nil {
+		return nil, newSerializationErrorWithContext(fmt.Sprintf("failed to decode profile %q", name), err, ErrorCodeValidation, nil)
+	}
+	if profile.Format > profileFormat {
+		return nil, newValidationErrorWithContext(fmt.Sprintf("profile %q uses format %d, newer than the supported format %d", name, profile.Format, profileFormat), nil, ErrorCodeValidation, nil)
+	}
+	if profile.Config == nil {
+		profile.Config = &ExtractionConfig{}
+	}
+	return &profile, nil
+}
+
+// profilePath returns the path of the profile file of name in dir.
+func profilePath(name, dir string) string {
+	return filepath.Join(dir, name+profileExt)
+}
+
+// validateProfileName rejects names that are empty, hidden, or not safe to use as
+// a file name.
+func validateProfileName(name string) error {
+	if name == "" {
+		return newValidationErrorWithContext("profile name cannot be empty", nil, ErrorCodeValidation, nil)
+	}
+	if strings.HasPrefix(name, ".") {
+		return newValidationErrorWithContext(fmt.Sprintf("invalid profile name: %q (must not start with '.')", name), nil, ErrorCodeValidation, nil)
+	}
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '-', r == '_', r == '.':
+		default:
+			return newValidationErrorWithContext(fmt.Sprintf("invalid profile name: %q (valid characters: letters, digits, '-', '_', '.')", name), nil, ErrorCodeValidation, nil)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestProfileRoundTrip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "profiles")
+	config := NewExtractionConfig(
+		WithUseCache(false),
+		WithOutputFormat("markdown"),
+		WithChunking(WithMaxChars(800)),
+	)
+
+	if err := SaveProfile("invoices", config, dir); err != nil {
+		t.Fatalf("SaveProfile: %v", err)
+	}
+	if err := SaveProfile("invoices", config, dir); err != nil {
+		t.Fatalf("SaveProfile again: %v", err)
+	}
+	if err := SaveProfile("contracts.v2", &ExtractionConfig{}, dir); err != nil {
+		t.Fatalf("SaveProfile: %v", err)
+	}
+
+	loaded, err := LoadProfile("invoices", dir)
+	if err != nil {
+		t.Fatalf("LoadProfile: %v", err)
+	}
+	if loaded.Fingerprint() != config.Fingerprint() {
+		t.Errorf("loaded config differs from saved config")
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "invoices.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stamp struct {
+		Format  int `json:"format"`
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &stamp); err != nil {
+		t.Fatal(err)
+	}
+	if stamp.Format != profileFormat || stamp.Version != 2 {
+		t.Errorf("expected format %d version 2, got format %d version %d", profileFormat, stamp.Format, stamp.Version)
+	}
+
+	names, err := ListProfiles(dir)
+	if err != nil {
+		t.Fatalf("ListProfiles: %v", err)
+	}
+	if want := []string{"contracts.v2", "invoices"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("profiles = %v, want %v", names, want)
+	}
+}
+
+func TestProfileErrors(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"", ".hidden", "../escape", "a/b"} {
+		if err := SaveProfile(name, &ExtractionConfig{}, dir); err == nil {
+			t.Errorf("expected error for profile name %q", name)
+		}
+	}
+	if _, err := LoadProfile("missing", dir); err == nil {
+		t.Error("expected error for missing profile")
+	}
+
+	future := []byte(`{"format": 99, "name": "future", "version": 1, "config": {}}`)
+	if err := os.WriteFile(filepath.Join(dir, "future.json"), future, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadProfile("future", dir); err == nil {
+		t.Error("expected error for newer profile format")
+	}
+
+	names, err := ListProfiles(filepath.Join(dir, "missing"))
+	if err != nil || names != nil {
+		t.Errorf("expected no profiles for missing directory, got %v, %v", names, err)
+	}
+}
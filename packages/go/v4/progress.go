@@ -0,0 +1,85 @@
+package kreuzberg
+
+import "context"
+
+// Stages reported in ProgressEvent.Stage.
+const (
+	// ProgressStageExtracting is reported when the native extraction starts.
+	ProgressStageExtracting = "extracting"
+	// ProgressStagePage is reported by ExtractFilePages after each page is extracted.
+	ProgressStagePage = "page"
+	// ProgressStagePostProcessing is reported when the native extraction has
+	// returned and Go-side options are being applied.
+	ProgressStagePostProcessing = "post_processing"
+	// ProgressStageComplete is reported when the extraction finished successfully.
+	ProgressStageComplete = "complete"
+)
+
+// ProgressEvent describes the progress of a running extraction. The native core
+// does not report progress from inside a call, so single-document extractions
+// only report coarse stages; page numbers are only set for ProgressStagePage.
+type ProgressEvent struct {
+	// Page is the page just extracted, 1-indexed, for ProgressStagePage events
+	// and 0 otherwise.
+	Page int
+	// TotalPages is the number of pages in the document for ProgressStagePage
+	// events and 0 otherwise.
+	TotalPages int
+	// Stage is one of the ProgressStage constants.
+	Stage string
+}
+
+// ProgressCallback receives progress events of a running extraction.
+//
+// ExtractFileSync, ExtractBytesSync, and their context variants report
+// ProgressStageExtracting when they start, ProgressStagePostProcessing once the
+// native extraction returns, and ProgressStageComplete when they succeed. There
+// are no events while the native call runs, which can take minutes for a large
+// scanned PDF; ExtractFilePages reports ProgressStagePage after each page instead.
+// The callback runs on the extracting goroutine and must not block. The context
+// variants stop delivering events once their context is done, and batch calls do
+// not report progress; see WithOnComplete.
+type ProgressCallback func(event ProgressEvent)
+
+// reportProgress sends event to config.ProgressCallback, if one is set.
+func reportProgress(config *ExtractionConfig, event ProgressEvent) {
+	if config != nil && config.ProgressCallback != nil {
+		config.ProgressCallback(event)
+	}
+}
+
+// progressContextConfig returns config with its ProgressCallback wrapped so that
+// no events are delivered once ctx is done. The caller's config is not modified.
+func progressContextConfig(ctx context.Context, config *ExtractionConfig) *ExtractionConfig {
+	if config == nil || config.ProgressCallback == nil {
+		return config
+	}
+	cfg := *config
+	cfg.ProgressCallback = progressContextCallback(ctx, config.ProgressCallback)
+	return &cfg
+}
+
+// progressContextCallback returns a callback that forwards to callback while ctx
+// is not done.
+func progressContextCallback(ctx context.Context, callback ProgressCallback) ProgressCallback {
+	if callback == nil {
+		return nil
+	}
+	return func(event ProgressEvent) {
+		if ctx.Err() == nil {
+			callback(event)
+		}
+	}
+}
+
+// finalizeWithProgress applies finalizeResult to the result of a single-document
+// extraction, reporting the post-processing and completion stages.
+func finalizeWithProgress(result *ExtractionResult, config *ExtractionConfig) (*ExtractionResult, error) {
+	reportProgress(config, ProgressEvent{Stage: ProgressStagePostProcessing})
+	result, err := finalizeResult(result, config)
+	if err != nil {
+		return nil, err
+	}
+	reportProgress(config, ProgressEvent{Stage: ProgressStageComplete})
+	return result, nil
+}
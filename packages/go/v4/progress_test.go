@@ -0,0 +1,87 @@
+package kreuzberg
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFinalizeWithProgress(t *testing.T) {
+	var events []ProgressEvent
+	config := NewExtractionConfig(WithProgressCallback(func(event ProgressEvent) {
+		events = append(events, event)
+	}))
+	result := &ExtractionResult{Metadata: Metadata{Pages: &PageStructure{TotalCount: 3}}}
+
+	if _, err := finalizeWithProgress(result, config); err != nil {
+		t.Fatalf("finalizeWithProgress failed: %v", err)
+	}
+	want := []ProgressEvent{
+		{Stage: ProgressStagePostProcessing},
+		{Stage: ProgressStageComplete},
+	}
+	if len(events) != len(want) {
+		t.Fatalf("expected %d events, got %+v", len(want), events)
+	}
+	for i := range want {
+		if events[i] != want[i] {
+			t.Errorf("event %d: got %+v, want %+v", i, events[i], want[i])
+		}
+	}
+}
+
+func TestProgressContextConfig(t *testing.T) {
+	calls := 0
+	config := NewExtractionConfig(WithProgressCallback(func(ProgressEvent) { calls++ }))
+	ctx, cancel := context.WithCancel(context.Background())
+	wrapped := progressContextConfig(ctx, config)
+
+	reportProgress(wrapped, ProgressEvent{Stage: ProgressStageExtracting})
+	cancel()
+	reportProgress(wrapped, ProgressEvent{Stage: ProgressStageComplete})
+
+	if calls != 1 {
+		t.Errorf("expected 1 event before cancellation, got %d", calls)
+	}
+	if progressContextConfig(ctx, nil) != nil {
+		t.Error("expected nil config to stay nil")
+	}
+	reportProgress(config, ProgressEvent{})
+	if calls != 2 {
+		t.Error("expected the caller's config to keep the unwrapped callback")
+	}
+}
+
+func TestPageIteratorReportsProgress(t *testing.T) {
+	var events []ProgressEvent
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	it := &PageIterator{
+		ctx:         ctx,
+		extractPage: func(page uint64) (*ExtractionResult, error) { return pagedResult(3, page), nil },
+		next:        1,
+		progress: progressContextCallback(ctx, func(event ProgressEvent) {
+			events = append(events, event)
+		}),
+	}
+	if err := it.fetch(); err != nil {
+		t.Fatalf("fetch failed: %v", err)
+	}
+	if _, err := it.Next(); err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	if _, err := it.Next(); err != nil {
+		t.Fatalf("Next failed: %v", err)
+	}
+	cancel()
+	_, _ = it.Next()
+
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events before cancellation, got %+v", events)
+	}
+	for i, event := range events {
+		want := ProgressEvent{Page: i + 1, TotalPages: 3, Stage: ProgressStagePage}
+		if event != want {
+			t.Errorf("event %d: got %+v, want %+v", i, event, want)
+		}
+	}
+}
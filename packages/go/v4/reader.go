@@ -0,0 +1,103 @@
+package kreuzberg
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ExtractReaderSync extracts content from r, which holds a document of the given
+// MIME type.
+//
+// The reader is streamed into a temporary file inside config.TempDir (or TMPDIR)
+// and extracted from there, so the document is never held in memory as a whole.
+// The temporary file is removed before ExtractReaderSync returns. Use
+// WithMaxReaderSize to bound how much is read from untrusted or unbounded readers.
+func ExtractReaderSync(r io.Reader, mimeType string, config *ExtractionConfig) (*ExtractionResult, error) {
+	return ExtractReaderWithContext(context.Background(), r, mimeType, config)
+}
+
+// ExtractReaderWithContext is like ExtractReaderSync but stops reading from r once
+// ctx is cancelled. Cancellation is checked between reads; a Read call that blocks
+// indefinitely cannot be interrupted. Cancellation during the extraction itself
+// behaves as for ExtractFileWithContext.
+func ExtractReaderWithContext(ctx context.Context, r io.Reader, mimeType string, config *ExtractionConfig) (*ExtractionResult, error) {
+	if r == nil {
+		return nil, newValidationErrorWithContext("reader cannot be nil", nil, ErrorCodeValidation, nil)
+	}
+	if mimeType == "" {
+		return nil, newValidationErrorWithContext("mimeType is required", nil, ErrorCodeValidation, nil)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	extensions, err := GetExtensionsForMime(mimeType)
+	if err != nil {
+		return nil, err
+	}
+	if len(extensions) == 0 {
+		return nil, newUnsupportedFormatErrorWithContext(mimeType, "", nil, ErrorCodeUnsupportedFormat, nil)
+	}
+
+	path, err := spoolReader(ctx, r, "."+strings.TrimPrefix(extensions[0], "."), config)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(path)
+
+	return ExtractFileWithContext(ctx, path, config)
+}
+
+// spoolReader copies r into a new temporary file with the given extension and
+// returns its path. The file is removed again if copying fails.
+func spoolReader(ctx context.Context, r io.Reader, ext string, config *ExtractionConfig) (string, error) {
+	dir := ""
+	if config != nil && config.TempDir != nil {
+		dir = *config.TempDir
+	}
+	f, err := os.CreateTemp(dir, "kreuzberg-reader-*"+ext)
+	if err != nil {
+		return "", newTempDirErrorWithContext(dir, "", err, ErrorCodeIo, nil)
+	}
+
+	src := io.Reader(&contextReader{ctx: ctx, r: r})
+	limit := int64(-1)
+	if config != nil && config.MaxReaderSize != nil {
+		limit = *config.MaxReaderSize
+		src = io.LimitReader(src, limit+1)
+	}
+
+	written, copyErr := io.Copy(f, src)
+	closeErr := f.Close()
+	switch {
+	case copyErr != nil && ctx.Err() != nil:
+		err = ctx.Err()
+	case copyErr != nil:
+		err = newIOErrorWithContext("failed to read document", copyErr, ErrorCodeIo, nil)
+	case limit >= 0 && written > limit:
+		err = newValidationErrorWithContext(fmt.Sprintf("document exceeds maximum reader size of %d bytes", limit), nil, ErrorCodeValidation, nil)
+	case closeErr != nil:
+		err = newIOErrorWithContext("failed to write temporary file", closeErr, ErrorCodeIo, nil)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// contextReader fails reads once its context is done.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(p)
+}
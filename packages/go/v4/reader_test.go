@@ -0,0 +1,67 @@
+package kreuzberg
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kreuzberg-dev/kreuzberg/packages/go/v4/kreuzbergtest"
+)
+
+func TestSpoolReader(t *testing.T) {
+	dir := t.TempDir()
+	config := NewExtractionConfig(WithTempDir(dir))
+
+	path, err := spoolReader(context.Background(), strings.NewReader("hello"), ".txt", config)
+	if err != nil {
+		t.Fatalf("spoolReader failed: %v", err)
+	}
+	if filepath.Dir(path) != dir || filepath.Ext(path) != ".txt" {
+		t.Errorf("unexpected spool path %s", path)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "hello" {
+		t.Errorf("unexpected spooled content %q (%v)", data, err)
+	}
+}
+
+func TestSpoolReader_SizeLimit(t *testing.T) {
+	dir := t.TempDir()
+	config := NewExtractionConfig(WithTempDir(dir), WithMaxReaderSize(4))
+
+	_, err := spoolReader(context.Background(), strings.NewReader("hello"), ".txt", config)
+	if _, ok := err.(*ValidationError); !ok {
+		t.Fatalf("expected ValidationError, got %v", err)
+	}
+	kreuzbergtest.AssertNoTempLeaks(t, dir)
+
+	path, err := spoolReader(context.Background(), strings.NewReader("four"), ".txt", config)
+	if err != nil {
+		t.Fatalf("expected input at the limit to be accepted, got %v", err)
+	}
+	_ = os.Remove(path)
+}
+
+func TestSpoolReader_Cancelled(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := spoolReader(ctx, strings.NewReader("hello"), ".txt", NewExtractionConfig(WithTempDir(dir)))
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	kreuzbergtest.AssertNoTempLeaks(t, dir)
+}
+
+func TestExtractReaderSync_Validation(t *testing.T) {
+	if _, err := ExtractReaderSync(nil, "application/pdf", nil); err == nil {
+		t.Error("expected error for nil reader")
+	}
+	if _, err := ExtractReaderSync(strings.NewReader("x"), "", nil); err == nil {
+		t.Error("expected error for empty MIME type")
+	}
+}
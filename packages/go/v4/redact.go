@@ -0,0 +1,203 @@
+package kreuzberg
+
+import "strings"
+
+// redactImages removes image references from the result's content and page texts
+// and drops all extracted images, recording how many images were redacted.
+func redactImages(result *ExtractionResult) {
+	var removed int
+	result.Content, removed = redactImageRefs(result.Content)
+	for i := range result.Pages {
+		result.Pages[i].Content, _ = redactImageRefs(result.Pages[i].Content)
+		result.Pages[i].Images = nil
+	}
+	result.RedactedImageCount = max(removed, len(result.Images))
+	result.Images = nil
+}
+
+// redactImageRefs removes markdown and djot images ("![alt](src)", "![alt][ref]"),
+// images that are the only content of a link ("[![alt](src)](href)"), and HTML
+// <img> tags from text, returning the new text and the number of images removed.
+// Fenced code blocks and inline code spans are left untouched, and lines that
+// held nothing but images are dropped.
+func redactImageRefs(text string) (string, int) {
+	if !strings.Contains(text, "![") && !strings.Contains(strings.ToLower(text), "<img") {
+		return text, 0
+	}
+
+	lines := strings.Split(text, "\n")
+	out := lines[:0]
+	total := 0
+	inFence := false
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			out = append(out, line)
+			continue
+		}
+		if inFence {
+			out = append(out, line)
+			continue
+		}
+		redacted, n := redactImageLine(line)
+		total += n
+		if n > 0 && strings.TrimSpace(redacted) == "" {
+			continue
+		}
+		out = append(out, redacted)
+	}
+	if total == 0 {
+		return text, 0
+	}
+	return strings.Join(out, "\n"), total
+}
+
+// redactImageLine removes the images from a single line outside of code blocks.
+func redactImageLine(line string) (string, int) {
+	var b strings.Builder
+	removed := 0
+	for i := 0; i < len(line); {
+		switch {
+		case line[i] == '`':
+			end := codeSpanEnd(line, i)
+			b.WriteString(line[i:end])
+			i = end
+			continue
+		case line[i] == '\\' && i+1 < len(line):
+			b.WriteString(line[i : i+2])
+			i += 2
+			continue
+		case strings.HasPrefix(line[i:], "[!["):
+			if end := linkedImageEnd(line, i); end > 0 {
+				removed++
+				i = end
+				continue
+			}
+		case strings.HasPrefix(line[i:], "!["):
+			if end := imageEnd(line, i); end > 0 {
+				removed++
+				i = end
+				continue
+			}
+		case line[i] == '<' && isImgTag(line[i:]):
+			if end := htmlTagEnd(line, i); end > 0 {
+				removed++
+				i = end
+				continue
+			}
+		}
+		b.WriteByte(line[i])
+		i++
+	}
+	if removed == 0 {
+		return line, 0
+	}
+	return b.String(), removed
+}
+
+// imageEnd returns the index just past the image starting with "![" at start,
+// including a trailing djot attribute block, or 0 when there is no image there.
+func imageEnd(s string, start int) int {
+	end := closingDelimiter(s, start+1, '[', ']')
+	if end == 0 || end >= len(s) {
+		return 0
+	}
+	switch s[end] {
+	case '(':
+		end = closingDelimiter(s, end, '(', ')')
+	case '[':
+		end = closingDelimiter(s, end, '[', ']')
+	default:
+		return 0
+	}
+	if end > 0 && end < len(s) && s[end] == '{' {
+		if attrEnd := closingDelimiter(s, end, '{', '}'); attrEnd > 0 {
+			end = attrEnd
+		}
+	}
+	return end
+}
+
+// linkedImageEnd returns the index just past a link starting at start whose only
+// content is an image, or 0 when there is no such link there.
+func linkedImageEnd(s string, start int) int {
+	end := imageEnd(s, start+1)
+	if end == 0 || end+1 >= len(s) || s[end] != ']' {
+		return 0
+	}
+	switch s[end+1] {
+	case '(':
+		return closingDelimiter(s, end+1, '(', ')')
+	case '[':
+		return closingDelimiter(s, end+1, '[', ']')
+	default:
+		return 0
+	}
+}
+
+// closingDelimiter returns the index just past the delimiter that closes the one
+// at s[start], honouring nesting and backslash escapes, or 0 when it is unclosed.
+func closingDelimiter(s string, start int, opening, closing byte) int {
+	depth := 0
+	for i := start; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case opening:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return 0
+}
+
+// codeSpanEnd returns the index just past the inline code span starting at start.
+// An unmatched backtick run is returned as literal text.
+func codeSpanEnd(s string, start int) int {
+	run := start
+	for run < len(s) && s[run] == '`' {
+		run++
+	}
+	fence := s[start:run]
+	if closing := strings.Index(s[run:], fence); closing >= 0 {
+		return run + closing + len(fence)
+	}
+	return run
+}
+
+// isImgTag reports whether s starts with an HTML <img> tag.
+func isImgTag(s string) bool {
+	if len(s) < 5 || !strings.EqualFold(s[:4], "<img") {
+		return false
+	}
+	switch s[4] {
+	case ' ', '\t', '/', '>':
+		return true
+	default:
+		return false
+	}
+}
+
+// htmlTagEnd returns the index just past the HTML tag starting at start, skipping
+// '>' inside quoted attribute values, or 0 when the tag is unclosed.
+func htmlTagEnd(s string, start int) int {
+	var quote byte
+	for i := start + 1; i < len(s); i++ {
+		switch {
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case s[i] == '>':
+			return i + 1
+		}
+	}
+	return 0
+}
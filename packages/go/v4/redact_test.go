@@ -0,0 +1,59 @@
+package kreuzberg
+
+import "testing"
+
+func TestRedactImageRefs(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		want    string
+		removed int
+	}{
+		{"no images", "plain [link](a.html) text", "plain [link](a.html) text", 0},
+		{"inline markdown", "See ![a *logo*](img/logo.png \"Logo\") here.", "See  here.", 1},
+		{"reference image", "Before ![chart][fig1] after", "Before  after", 1},
+		{"nested brackets and parens", "x ![a [b] c](img/a_(1).png) y", "x  y", 1},
+		{"djot attributes", "![face](face.jpg){width=50} caption", " caption", 1},
+		{"linked image", "Go [![badge](b.svg)](https://ci.example.com) now", "Go  now", 1},
+		{"html img", `<p>Hi <img src="a.png" alt="a > b"/> there</p>`, "<p>Hi  there</p>", 1},
+		{"image-only line dropped", "# Title\n\n![sig](sig.png)\n\nBody", "# Title\n\n\nBody", 1},
+		{"code span kept", "Use `![x](y)` syntax ![z](z.png)", "Use `![x](y)` syntax ", 1},
+		{"fenced code kept", "```\n![x](y)\n```\n![z](z.png)", "```\n![x](y)\n```", 1},
+		{"escaped not an image", `\![not](image)`, `\![not](image)`, 0},
+		{"unclosed left alone", "broken ![alt(img.png", "broken ![alt(img.png", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, removed := redactImageRefs(tt.in)
+			if got != tt.want {
+				t.Errorf("redactImageRefs(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+			if removed != tt.removed {
+				t.Errorf("expected %d removed images, got %d", tt.removed, removed)
+			}
+		})
+	}
+}
+
+func TestFinalizeResult_RedactImages(t *testing.T) {
+	config := NewExtractionConfig(WithRedactImages(true))
+	result := &ExtractionResult{
+		Content: "Intro ![photo](p1.png)",
+		Images:  []ExtractedImage{{}, {}},
+		Pages:   []PageContent{{PageNumber: 1, Content: "![photo](p1.png)\ntext", Images: []ExtractedImage{{}}}},
+	}
+
+	if _, err := finalizeResult(result, config); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if result.Content != "Intro " || result.Pages[0].Content != "text" {
+		t.Errorf("unexpected content: %q / %q", result.Content, result.Pages[0].Content)
+	}
+	if result.Images != nil || result.Pages[0].Images != nil {
+		t.Error("expected images to be omitted")
+	}
+	if result.RedactedImageCount != 2 {
+		t.Errorf("expected 2 redacted images, got %d", result.RedactedImageCount)
+	}
+}
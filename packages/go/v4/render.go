@@ -0,0 +1,479 @@
+package kreuzberg
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RenderAs renders the result's structured content in format, so a caller that
+// extracted as one output format can obtain another without extracting the
+// document again:
+//
+//	result, err := kreuzberg.ExtractFileSync(path, kreuzberg.NewExtractionConfig(
+//		kreuzberg.WithOutputFormat(string(kreuzberg.OutputFormatDjot))))
+//	...
+//	page, err := result.RenderAs(kreuzberg.OutputFormatHTML)
+//
+// The structured Djot content reported in result.DjotContent when extracting with
+// OutputFormatDjot is rendered with its block structure and inline formatting.
+// Results without it are rendered from result.Elements, reported with
+// ResultFormatElementBased. Other results carry only their final Content and
+// cannot be converted; RenderAs reports a *ValidationError for them. Tables are
+// rendered only where the structured content places them; see result.Tables for
+// all tables of the document.
+//
+// Document content is untrusted, so HTML output escapes all text, including raw
+// blocks and raw inline content, which are rendered as text rather than passed
+// through. Links whose scheme is not http, https, or mailto, such as javascript:
+// URLs, are rendered as their text alone in every format.
+func (r *ExtractionResult) RenderAs(format OutputFormat) (string, error) {
+	if r == nil {
+		return "", newValidationErrorWithContext("result cannot be nil", nil, ErrorCodeValidation, nil)
+	}
+	if err := ValidateContentFormat(string(format)); err != nil {
+		return "", err
+	}
+	switch format {
+	case OutputFormatText:
+		format = OutputFormatPlain
+	case OutputFormatMd:
+		format = OutputFormatMarkdown
+	}
+
+	rd := &renderer{format: format}
+	switch {
+	case r.DjotContent != nil:
+		if format == OutputFormatPlain && r.DjotContent.PlainText != "" {
+			return r.DjotContent.PlainText, nil
+		}
+		rd.blocks(r.DjotContent.Blocks, "")
+		rd.footnotes(r.DjotContent.Footnotes)
+	case len(r.Elements) > 0:
+		rd.elements(r.Elements)
+	default:
+		return "", newValidationErrorWithContext("result has no structured content to render (extract with OutputFormatDjot or ResultFormatElementBased)", nil, ErrorCodeValidation, nil)
+	}
+	return strings.TrimRight(rd.b.String(), "\n") + "\n", nil
+}
+
+// renderer writes structured content in one output format.
+type renderer struct {
+	format OutputFormat
+	b      strings.Builder
+}
+
+// blocks writes blocks, prefixing every line with indent, which carries list
+// indentation and block quote markers in the text formats.
+func (rd *renderer) blocks(blocks []FormattedBlock, indent string) {
+	for i := range blocks {
+		rd.block(&blocks[i], indent)
+	}
+}
+
+// block writes a single block followed by a blank line in the text formats.
+func (rd *renderer) block(block *FormattedBlock, indent string) {
+	if rd.format == OutputFormatHTML {
+		rd.htmlBlock(block)
+		return
+	}
+
+	switch block.BlockType {
+	case BlockTypeHeading:
+		level := 1
+		if block.Level != nil {
+			level = min(max(int(*block.Level), 1), 6)
+		}
+		if rd.format == OutputFormatPlain {
+			rd.line(indent, rd.inlines(block.InlineContent))
+		} else {
+			rd.line(indent, strings.Repeat("#", level)+" "+rd.inlines(block.InlineContent))
+		}
+	case BlockTypeBlockquote:
+		quote := indent + "> "
+		if rd.format == OutputFormatPlain {
+			quote = indent
+		}
+		rd.paragraph(quote, block.InlineContent)
+		rd.blocks(block.Children, quote)
+		return
+	case BlockTypeCodeBlock, BlockTypeRawBlock, BlockTypeMathDisplay:
+		rd.textBlock(block, indent)
+	case BlockTypeBulletList, BlockTypeOrderedList, BlockTypeTaskList, BlockTypeDefinitionList:
+		rd.list(block, indent)
+		return
+	case BlockTypeThematicBreak:
+		switch rd.format {
+		case OutputFormatDjot:
+			rd.line(indent, "* * *")
+		case OutputFormatMarkdown:
+			rd.line(indent, "---")
+		}
+	default:
+		rd.paragraph(indent, block.InlineContent)
+		rd.blocks(block.Children, indent)
+		return
+	}
+	rd.line(indent, "")
+}
+
+// paragraph writes inline content as a paragraph, if there is any.
+func (rd *renderer) paragraph(indent string, inlines []InlineElement) {
+	if text := rd.inlines(inlines); text != "" {
+		rd.line(indent, text)
+		rd.line(indent, "")
+	}
+}
+
+// textBlock writes a code, raw, or display math block.
+func (rd *renderer) textBlock(block *FormattedBlock, indent string) {
+	text := rd.inlinesText(block.InlineContent)
+	if block.Code != nil {
+		text = *block.Code
+	}
+	text = strings.TrimRight(text, "\n")
+
+	switch {
+	case rd.format == OutputFormatPlain || block.BlockType == BlockTypeRawBlock:
+		rd.line(indent, text)
+	case block.BlockType == BlockTypeMathDisplay && rd.format == OutputFormatDjot:
+		rd.line(indent, "$$`"+text+"`")
+	case block.BlockType == BlockTypeMathDisplay:
+		rd.line(indent, "$$")
+		rd.line(indent, text)
+		rd.line(indent, "$$")
+	default:
+		language := ""
+		if block.Language != nil {
+			language = *block.Language
+		}
+		rd.line(indent, "```"+language)
+		rd.line(indent, text)
+		rd.line(indent, "```")
+	}
+}
+
+// list writes the items of a list block, nesting child blocks under each item.
+func (rd *renderer) list(block *FormattedBlock, indent string) {
+	for i := range block.Children {
+		item := &block.Children[i]
+		var marker string
+		switch {
+		case block.BlockType == BlockTypeOrderedList:
+			marker = strconv.Itoa(i+1) + ". "
+		case block.BlockType == BlockTypeTaskList:
+			marker = "- [ ] "
+			if item.Attributes != nil && hasClass(item.Attributes, "checked") {
+				marker = "- [x] "
+			}
+		case item.BlockType == BlockTypeDefinitionTerm:
+			rd.line(indent, rd.inlines(item.InlineContent))
+			continue
+		case item.BlockType == BlockTypeDefinitionDesc:
+			marker = ": "
+		default:
+			marker = "- "
+		}
+		if rd.format == OutputFormatPlain && block.BlockType != BlockTypeOrderedList {
+			marker = "• "
+		}
+		rd.line(indent, marker+rd.inlines(item.InlineContent))
+		childIndent := indent + strings.Repeat(" ", len([]rune(marker)))
+		for j := range item.Children {
+			child := &item.Children[j]
+			if child.BlockType == BlockTypeParagraph {
+				rd.line(childIndent, rd.inlines(child.InlineContent))
+				continue
+			}
+			rd.block(child, childIndent)
+		}
+	}
+	rd.line(indent, "")
+}
+
+// footnotes writes the footnote definitions after the document body.
+func (rd *renderer) footnotes(footnotes []Footnote) {
+	if len(footnotes) == 0 {
+		return
+	}
+	if rd.format == OutputFormatHTML {
+		rd.b.WriteString("<section class=\"footnotes\">\n<ol>\n")
+		for _, footnote := range footnotes {
+			fmt.Fprintf(&rd.b, "<li id=\"fn-%s\">\n", html.EscapeString(footnote.Label))
+			rd.blocks(footnote.Content, "")
+			rd.b.WriteString("</li>\n")
+		}
+		rd.b.WriteString("</ol>\n</section>\n")
+		return
+	}
+	for _, footnote := range footnotes {
+		prefix := "[^" + footnote.Label + "]: "
+		if rd.format == OutputFormatPlain {
+			prefix = "[" + footnote.Label + "] "
+		}
+		var body strings.Builder
+		for _, block := range footnote.Content {
+			if body.Len() > 0 {
+				body.WriteString(" ")
+			}
+			body.WriteString(rd.inlines(block.InlineContent))
+		}
+		rd.line("", prefix+body.String())
+	}
+	rd.line("", "")
+}
+
+// line writes text prefixed with indent, trimming trailing spaces of blank lines.
+func (rd *renderer) line(indent, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		if line == "" {
+			rd.b.WriteString(strings.TrimRight(indent, " "))
+		} else {
+			rd.b.WriteString(indent + line)
+		}
+		rd.b.WriteString("\n")
+	}
+}
+
+// inlines renders inline content in the renderer's format.
+func (rd *renderer) inlines(inlines []InlineElement) string {
+	var b strings.Builder
+	for _, inline := range inlines {
+		b.WriteString(rd.inline(inline))
+	}
+	return b.String()
+}
+
+// inlinesText returns the unformatted text of inline content.
+func (rd *renderer) inlinesText(inlines []InlineElement) string {
+	var b strings.Builder
+	for _, inline := range inlines {
+		b.WriteString(inline.Content)
+	}
+	return b.String()
+}
+
+// inlineDelimiters lists, per format, the markup placed around formatted text.
+// Formats without an equivalent markup render the text unformatted.
+var inlineDelimiters = map[OutputFormat]map[InlineType][2]string{
+	OutputFormatMarkdown: {
+		InlineTypeStrong:   {"**", "**"},
+		InlineTypeEmphasis: {"*", "*"},
+		InlineTypeDelete:   {"~~", "~~"},
+		InlineTypeCode:     {"`", "`"},
+		InlineTypeMath:     {"$", "$"},
+	},
+	OutputFormatDjot: {
+		InlineTypeStrong:      {"*", "*"},
+		InlineTypeEmphasis:    {"_", "_"},
+		InlineTypeHighlight:   {"{=", "=}"},
+		InlineTypeSubscript:   {"~", "~"},
+		InlineTypeSuperscript: {"^", "^"},
+		InlineTypeInsert:      {"{+", "+}"},
+		InlineTypeDelete:      {"{-", "-}"},
+		InlineTypeCode:        {"`", "`"},
+		InlineTypeMath:        {"$`", "`"},
+		InlineTypeSymbol:      {":", ":"},
+	},
+	OutputFormatHTML: {
+		InlineTypeStrong:      {"<strong>", "</strong>"},
+		InlineTypeEmphasis:    {"<em>", "</em>"},
+		InlineTypeHighlight:   {"<mark>", "</mark>"},
+		InlineTypeSubscript:   {"<sub>", "</sub>"},
+		InlineTypeSuperscript: {"<sup>", "</sup>"},
+		InlineTypeInsert:      {"<ins>", "</ins>"},
+		InlineTypeDelete:      {"<del>", "</del>"},
+		InlineTypeCode:        {"<code>", "</code>"},
+		InlineTypeMath:        {"<span class=\"math\">", "</span>"},
+	},
+}
+
+// inline renders a single inline element.
+func (rd *renderer) inline(inline InlineElement) string {
+	content := inline.Content
+	if rd.format == OutputFormatHTML {
+		content = html.EscapeString(content)
+	}
+
+	switch inline.ElementType {
+	case InlineTypeLink:
+		href := inline.Metadata["href"]
+		if !isSafeLinkHref(href) {
+			return content
+		}
+		switch rd.format {
+		case OutputFormatHTML:
+			return fmt.Sprintf("<a href=\"%s\">%s</a>", html.EscapeString(href), content)
+		case OutputFormatMarkdown, OutputFormatDjot:
+			if href != "" {
+				return "[" + content + "](" + href + ")"
+			}
+		}
+		return content
+	case InlineTypeImage:
+		src, alt := inline.Metadata["src"], inline.Metadata["alt"]
+		if alt == "" {
+			alt = inline.Content
+		}
+		switch rd.format {
+		case OutputFormatHTML:
+			return fmt.Sprintf("<img src=\"%s\" alt=\"%s\">", html.EscapeString(src), html.EscapeString(alt))
+		case OutputFormatMarkdown, OutputFormatDjot:
+			return "![" + alt + "](" + src + ")"
+		}
+		return alt
+	case InlineTypeFootnoteRef:
+		switch rd.format {
+		case OutputFormatHTML:
+			return fmt.Sprintf("<sup><a href=\"#fn-%s\">%s</a></sup>", content, content)
+		case OutputFormatMarkdown, OutputFormatDjot:
+			return "[^" + inline.Content + "]"
+		}
+		return "[" + content + "]"
+	}
+
+	if delimiters, ok := inlineDelimiters[rd.format][inline.ElementType]; ok {
+		return delimiters[0] + content + delimiters[1]
+	}
+	return content
+}
+
+// isSafeLinkHref reports whether href is a relative reference or uses the http,
+// https, or mailto scheme.
+func isSafeLinkHref(href string) bool {
+	u, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	default:
+		return false
+	}
+}
+
+// htmlBlock writes a block as HTML.
+func (rd *renderer) htmlBlock(block *FormattedBlock) {
+	inlines := rd.inlines(block.InlineContent)
+	switch block.BlockType {
+	case BlockTypeHeading:
+		level := 1
+		if block.Level != nil {
+			level = min(max(int(*block.Level), 1), 6)
+		}
+		fmt.Fprintf(&rd.b, "<h%d>%s</h%d>\n", level, inlines, level)
+	case BlockTypeCodeBlock:
+		code := rd.inlinesText(block.InlineContent)
+		if block.Code != nil {
+			code = *block.Code
+		}
+		class := ""
+		if block.Language != nil && *block.Language != "" {
+			class = fmt.Sprintf(" class=\"language-%s\"", html.EscapeString(*block.Language))
+		}
+		fmt.Fprintf(&rd.b, "<pre><code%s>%s</code></pre>\n", class, html.EscapeString(strings.TrimRight(code, "\n")))
+	case BlockTypeRawBlock:
+		raw := rd.inlinesText(block.InlineContent)
+		if block.Code != nil {
+			raw = *block.Code
+		}
+		fmt.Fprintf(&rd.b, "<pre>%s</pre>\n", html.EscapeString(strings.TrimRight(raw, "\n")))
+	case BlockTypeMathDisplay:
+		math := rd.inlinesText(block.InlineContent)
+		if block.Code != nil {
+			math = *block.Code
+		}
+		fmt.Fprintf(&rd.b, "<div class=\"math\">%s</div>\n", html.EscapeString(math))
+	case BlockTypeThematicBreak:
+		rd.b.WriteString("<hr>\n")
+	default:
+		tag, ok := htmlBlockTags[block.BlockType]
+		if !ok {
+			tag = "p"
+		}
+		if len(block.Children) == 0 {
+			if inlines != "" || tag != "p" {
+				fmt.Fprintf(&rd.b, "<%s>%s</%s>\n", tag, inlines, tag)
+			}
+			return
+		}
+		fmt.Fprintf(&rd.b, "<%s>", tag)
+		if inlines != "" {
+			rd.b.WriteString(inlines)
+		}
+		rd.b.WriteString("\n")
+		rd.blocks(block.Children, "")
+		fmt.Fprintf(&rd.b, "</%s>\n", tag)
+	}
+}
+
+// htmlBlockTags maps container and text blocks to their HTML elements.
+var htmlBlockTags = map[BlockType]string{
+	BlockTypeParagraph:      "p",
+	BlockTypeBlockquote:     "blockquote",
+	BlockTypeListItem:       "li",
+	BlockTypeOrderedList:    "ol",
+	BlockTypeBulletList:     "ul",
+	BlockTypeTaskList:       "ul",
+	BlockTypeDefinitionList: "dl",
+	BlockTypeDefinitionTerm: "dt",
+	BlockTypeDefinitionDesc: "dd",
+	BlockTypeDiv:            "div",
+	BlockTypeSection:        "section",
+}
+
+// hasClass reports whether attrs carry class.
+func hasClass(attrs *Attributes, class string) bool {
+	for _, c := range attrs.Classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// elements renders element-based results, which carry text and a semantic type
+// for every element but no inline formatting. Consecutive list items are
+// rendered as one list.
+func (rd *renderer) elements(elements []Element) {
+	var list *FormattedBlock
+	flush := func() {
+		if list != nil {
+			rd.block(list, "")
+			list = nil
+		}
+	}
+	for _, element := range elements {
+		block := FormattedBlock{
+			BlockType:     BlockTypeParagraph,
+			InlineContent: []InlineElement{{ElementType: InlineTypeText, Content: element.Text}},
+		}
+		switch element.ElementType {
+		case ElementTypeListItem:
+			if list == nil {
+				list = &FormattedBlock{BlockType: BlockTypeBulletList}
+			}
+			block.BlockType = BlockTypeListItem
+			list.Children = append(list.Children, block)
+			continue
+		case ElementTypeHeader, ElementTypeFooter:
+			continue
+		case ElementTypeTitle:
+			block.BlockType, block.Level = BlockTypeHeading, Uint64Ptr(1)
+		case ElementTypeHeading:
+			block.BlockType, block.Level = BlockTypeHeading, Uint64Ptr(2)
+		case ElementTypeCodeBlock:
+			block.BlockType = BlockTypeCodeBlock
+		case ElementTypeBlockQuote:
+			block.BlockType = BlockTypeBlockquote
+		case ElementTypePageBreak:
+			block = FormattedBlock{BlockType: BlockTypeThematicBreak}
+		}
+		flush()
+		rd.block(&block, "")
+	}
+	flush()
+}
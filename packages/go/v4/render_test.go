@@ -0,0 +1,130 @@
+package kreuzberg
+
+import (
+	"errors"
+	"testing"
+)
+
+func renderTestResult() *ExtractionResult {
+	text := func(s string) InlineElement { return InlineElement{ElementType: InlineTypeText, Content: s} }
+	return &ExtractionResult{DjotContent: &DjotContent{
+		Blocks: []FormattedBlock{
+			{BlockType: BlockTypeHeading, Level: Uint64Ptr(2), InlineContent: []InlineElement{text("Results")}},
+			{BlockType: BlockTypeParagraph, InlineContent: []InlineElement{
+				text("Sales "),
+				{ElementType: InlineTypeStrong, Content: "grew"},
+				text(" <5% per "),
+				{ElementType: InlineTypeLink, Content: "year", Metadata: map[string]string{"href": "https://example.com"}},
+				{ElementType: InlineTypeFootnoteRef, Content: "1"},
+			}},
+			{BlockType: BlockTypeBulletList, Children: []FormattedBlock{
+				{BlockType: BlockTypeListItem, InlineContent: []InlineElement{text("north")}},
+				{BlockType: BlockTypeListItem, InlineContent: []InlineElement{text("south")}},
+			}},
+			{BlockType: BlockTypeCodeBlock, Language: StringPtr("go"), Code: StringPtr("x := 1\n")},
+		},
+		Footnotes: []Footnote{{Label: "1", Content: []FormattedBlock{
+			{BlockType: BlockTypeParagraph, InlineContent: []InlineElement{text("Unaudited.")}},
+		}}},
+	}}
+}
+
+func TestRenderAs_DjotContent(t *testing.T) {
+	tests := map[OutputFormat]string{
+		OutputFormatMarkdown: "## Results\n\nSales **grew** <5% per [year](https://example.com)[^1]\n\n- north\n- south\n\n```go\nx := 1\n```\n\n[^1]: Unaudited.\n",
+		OutputFormatDjot:     "## Results\n\nSales *grew* <5% per [year](https://example.com)[^1]\n\n- north\n- south\n\n```go\nx := 1\n```\n\n[^1]: Unaudited.\n",
+		OutputFormatPlain:    "Results\n\nSales grew <5% per year[1]\n\n• north\n• south\n\nx := 1\n\n[1] Unaudited.\n",
+		OutputFormatHTML: "<h2>Results</h2>\n<p>Sales <strong>grew</strong> &lt;5% per <a href=\"https://example.com\">year</a><sup><a href=\"#fn-1\">1</a></sup></p>\n" +
+			"<ul>\n<li>north</li>\n<li>south</li>\n</ul>\n<pre><code class=\"language-go\">x := 1</code></pre>\n" +
+			"<section class=\"footnotes\">\n<ol>\n<li id=\"fn-1\">\n<p>Unaudited.</p>\n</li>\n</ol>\n</section>\n",
+	}
+	for format, want := range tests {
+		got, err := renderTestResult().RenderAs(format)
+		if err != nil {
+			t.Fatalf("RenderAs(%s) failed: %v", format, err)
+		}
+		if got != want {
+			t.Errorf("RenderAs(%s) =\n%q\nwant\n%q", format, got, want)
+		}
+	}
+
+	result := renderTestResult()
+	result.DjotContent.PlainText = "plain text"
+	if got, _ := result.RenderAs(OutputFormatText); got != "plain text" {
+		t.Errorf("expected plain rendering to use PlainText, got %q", got)
+	}
+}
+
+func TestRenderAs_Elements(t *testing.T) {
+	result := &ExtractionResult{Elements: []Element{
+		{ElementType: ElementTypeTitle, Text: "Report"},
+		{ElementType: ElementTypeHeader, Text: "ACME Corp"},
+		{ElementType: ElementTypeNarrativeText, Text: "Summary."},
+		{ElementType: ElementTypeListItem, Text: "one"},
+		{ElementType: ElementTypeListItem, Text: "two"},
+	}}
+	got, err := result.RenderAs(OutputFormatMd)
+	if err != nil {
+		t.Fatalf("RenderAs failed: %v", err)
+	}
+	if want := "# Report\n\nSummary.\n\n- one\n- two\n"; got != want {
+		t.Errorf("unexpected markdown:\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestRenderAs_EscapesRawContent(t *testing.T) {
+	result := &ExtractionResult{DjotContent: &DjotContent{Blocks: []FormattedBlock{
+		{BlockType: BlockTypeRawBlock, Code: StringPtr("<script>alert(1)</script>\n")},
+		{BlockType: BlockTypeParagraph, InlineContent: []InlineElement{
+			{ElementType: InlineTypeRawInline, Content: "<img src=x onerror=alert(1)>"},
+		}},
+	}}}
+	got, err := result.RenderAs(OutputFormatHTML)
+	if err != nil {
+		t.Fatalf("RenderAs failed: %v", err)
+	}
+	want := "<pre>&lt;script&gt;alert(1)&lt;/script&gt;</pre>\n<p>&lt;img src=x onerror=alert(1)&gt;</p>\n"
+	if got != want {
+		t.Errorf("unexpected html:\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestRenderAs_DropsUnsafeLinks(t *testing.T) {
+	link := func(href string) *ExtractionResult {
+		return &ExtractionResult{DjotContent: &DjotContent{Blocks: []FormattedBlock{
+			{BlockType: BlockTypeParagraph, InlineContent: []InlineElement{
+				{ElementType: InlineTypeLink, Content: "click", Metadata: map[string]string{"href": href}},
+			}},
+		}}}
+	}
+	for _, href := range []string{"javascript:alert(1)", " JavaScript:alert(1)", "java\tscript:alert(1)", "data:text/html,<script>alert(1)</script>", "vbscript:msgbox(1)"} {
+		for _, format := range []OutputFormat{OutputFormatHTML, OutputFormatMarkdown, OutputFormatDjot} {
+			got, err := link(href).RenderAs(format)
+			if err != nil {
+				t.Fatalf("RenderAs(%s) failed: %v", format, err)
+			}
+			if got != "click\n" && got != "<p>click</p>\n" {
+				t.Errorf("RenderAs(%s) kept unsafe href %q: %q", format, href, got)
+			}
+		}
+	}
+	for href, want := range map[string]string{
+		"https://example.com":  "<p><a href=\"https://example.com\">click</a></p>\n",
+		"mailto:a@example.com": "<p><a href=\"mailto:a@example.com\">click</a></p>\n",
+		"../report.html#top":   "<p><a href=\"../report.html#top\">click</a></p>\n",
+	} {
+		if got, _ := link(href).RenderAs(OutputFormatHTML); got != want {
+			t.Errorf("RenderAs(html) for %q = %q, want %q", href, got, want)
+		}
+	}
+}
+
+func TestRenderAs_Errors(t *testing.T) {
+	var validationErr *ValidationError
+	if _, err := (&ExtractionResult{Content: "text"}).RenderAs(OutputFormatHTML); !errors.As(err, &validationErr) {
+		t.Errorf("expected validation error for result without structured content, got %v", err)
+	}
+	if _, err := renderTestResult().RenderAs("docx"); !errors.As(err, &validationErr) {
+		t.Errorf("expected validation error for unknown format, got %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package kreuzberg
+
+import "time"
+
+// StageTiming is the time spent in one stage of an extraction.
+type StageTiming struct {
+	// Stage is one of the ProgressStage constants.
+	Stage string `json:"stage"`
+	// Duration is the time from the start of the stage to the start of the next.
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// PageDPI is the detected input resolution of a scanned page.
+type PageDPI struct {
+	PageNumber uint64 `json:"page_number"`
+	DPI        int    `json:"dpi"`
+}
+
+// DiagnosticReport bundles what is needed to investigate an extraction, such as
+// for a support ticket. It is produced by ExtractWithReport and is meant to be
+// serialized as JSON.
+type DiagnosticReport struct {
+	// Path is the extracted file.
+	Path string `json:"path"`
+	// MimeType is the MIME type detected from the file, or the result's MIME type
+	// when detection failed.
+	MimeType string `json:"mime_type,omitempty"`
+	// Config is the caller's config, which the extraction ran with unchanged.
+	Config *ExtractionConfig `json:"config"`
+	// Duration is the wall-clock time of the extraction.
+	Duration time.Duration `json:"duration_ns"`
+	// Stages lists the time spent in each progress stage, in order. The native
+	// core does not report progress from inside a call, so these are only the
+	// coarse stages of WithProgressCallback: the native extraction and the
+	// Go-side post-processing.
+	Stages []StageTiming `json:"stages,omitempty"`
+	// Metrics holds the native per-stage timings when the native core provides them.
+	Metrics *ExtractionMetrics `json:"metrics,omitempty"`
+	// Warnings lists the non-fatal problems reported by the native core.
+	Warnings []string `json:"warnings,omitempty"`
+	// PageErrors lists the pages whose extraction failed.
+	PageErrors []PageError `json:"page_errors,omitempty"`
+	// DetectedDPI lists the detected resolution of every scanned page when the
+	// config requested pages.
+	DetectedDPI []PageDPI `json:"detected_dpi,omitempty"`
+	// Fonts lists the fonts used by the document when the config requested font
+	// info.
+	Fonts []FontInfo `json:"fonts,omitempty"`
+	// OCRFallback reports whether OCR ran because the document had no usable text layer.
+	OCRFallback bool `json:"ocr_fallback"`
+	// ResourceUsage reports the resources used by the extraction when the config
+	// enabled resource tracking.
+	ResourceUsage *ResourceStats `json:"resource_usage,omitempty"`
+	// Error is the error the extraction failed with, if any.
+	Error string `json:"error,omitempty"`
+	// Probe holds the findings of the separate diagnostic extraction.
+	Probe *DiagnosticProbe `json:"probe,omitempty"`
+}
+
+// DiagnosticProbe holds what the diagnostic extraction of ExtractWithReport
+// found. The probe runs after the reported extraction with the caller's config
+// plus font info and page extraction, and without Go-side hooks, so its outcome
+// can differ from the reported one.
+type DiagnosticProbe struct {
+	// Duration is the wall-clock time of the probe extraction.
+	Duration time.Duration `json:"duration_ns"`
+	// DetectedDPI lists the detected resolution of every scanned page.
+	DetectedDPI []PageDPI `json:"detected_dpi,omitempty"`
+	// Fonts lists the fonts used by the document.
+	Fonts []FontInfo `json:"fonts,omitempty"`
+	// Error is the error the probe failed with, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// ExtractWithReport extracts the file at path like ExtractFileSync and returns a
+// DiagnosticReport alongside the result:
+//
+//	result, report, err := kreuzberg.ExtractWithReport("bad.pdf", config)
+//	bundle, _ := json.MarshalIndent(report, "", "  ")
+//
+// The extraction runs with config unchanged, so the report describes the same
+// run as ExtractFileSync would; its ProgressCallback still receives every event.
+// Afterwards a second extraction probes the fonts and per-page DPI of the file
+// and is reported in report.Probe, so the file is extracted twice. The report is
+// returned even when the extraction fails, with Error set, so failed
+// extractions can be reported too.
+func ExtractWithReport(path string, config *ExtractionConfig) (*ExtractionResult, *DiagnosticReport, error) {
+	report := &DiagnosticReport{Path: path, Config: config}
+	if mimeType, err := DetectMimeTypeFromPath(path); err == nil {
+		report.MimeType = mimeType
+	}
+
+	timer := &stageTimer{}
+	cfg := cloneConfig(config)
+	progress := cfg.ProgressCallback
+	cfg.ProgressCallback = func(event ProgressEvent) {
+		timer.record(event.Stage)
+		if progress != nil {
+			progress(event)
+		}
+	}
+	start := time.Now()
+	result, err := ExtractFileSync(path, cfg)
+	report.Duration = time.Since(start)
+	report.Stages = timer.stages()
+	report.Probe = runDiagnosticProbe(path, config)
+	if err != nil {
+		report.Error = err.Error()
+		return nil, report, err
+	}
+
+	report.fill(result)
+	return result, report, nil
+}
+
+// runDiagnosticProbe extracts path with diagnosticProbeConfig and collects the
+// findings.
+func runDiagnosticProbe(path string, config *ExtractionConfig) *DiagnosticProbe {
+	probe := &DiagnosticProbe{}
+	start := time.Now()
+	result, err := ExtractFileSync(path, diagnosticProbeConfig(config))
+	probe.Duration = time.Since(start)
+	if err != nil {
+		probe.Error = err.Error()
+		return probe
+	}
+	probe.Fonts = result.Fonts
+	probe.DetectedDPI = detectedPageDPI(result.Pages)
+	return probe
+}
+
+// diagnosticProbeConfig returns a copy of config with font info and page
+// extraction enabled and without Go-side hooks.
+func diagnosticProbeConfig(config *ExtractionConfig) *ExtractionConfig {
+	cfg := cloneConfig(config)
+	if cfg.PdfOptions == nil {
+		cfg.PdfOptions = &PdfConfig{}
+	}
+	cfg.PdfOptions.ExtractFontInfo = BoolPtr(true)
+	if cfg.Pages == nil {
+		cfg.Pages = &PageConfig{}
+	}
+	cfg.Pages.ExtractPages = BoolPtr(true)
+	cfg.ContentCallback = nil
+	cfg.ContentValidator = nil
+	cfg.OnComplete = nil
+	cfg.ProgressCallback = nil
+	return cfg
+}
+
+// fill copies the diagnostic fields of result into the report.
+func (r *DiagnosticReport) fill(result *ExtractionResult) {
+	if r.MimeType == "" {
+		r.MimeType = result.MimeType
+	}
+	r.Metrics = result.Metrics
+	r.Warnings = result.Warnings
+	r.PageErrors = result.PageErrors
+	r.Fonts = result.Fonts
+	r.OCRFallback = result.OCRFallback
+	r.ResourceUsage = result.ResourceUsage
+	r.DetectedDPI = detectedPageDPI(result.Pages)
+}
+
+// detectedPageDPI lists the detected resolution of the scanned pages.
+func detectedPageDPI(pages []PageContent) []PageDPI {
+	var dpi []PageDPI
+	for _, page := range pages {
+		if page.DetectedDPI != nil {
+			dpi = append(dpi, PageDPI{PageNumber: page.PageNumber, DPI: *page.DetectedDPI})
+		}
+	}
+	return dpi
+}
+
+// stageTimer records when each progress stage starts.
+type stageTimer struct {
+	names  []string
+	starts []time.Time
+}
+
+// record notes that stage started now.
+func (t *stageTimer) record(stage string) {
+	t.names = append(t.names, stage)
+	t.starts = append(t.starts, time.Now())
+}
+
+// stages returns the duration of every recorded stage but the last, which marks
+// the end of the extraction.
+func (t *stageTimer) stages() []StageTiming {
+	if len(t.starts) < 2 {
+		return nil
+	}
+	timings := make([]StageTiming, 0, len(t.starts)-1)
+	for i := 1; i < len(t.starts); i++ {
+		timings = append(timings, StageTiming{Stage: t.names[i-1], Duration: t.starts[i].Sub(t.starts[i-1])})
+	}
+	return timings
+}
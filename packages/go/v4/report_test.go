@@ -0,0 +1,92 @@
+package kreuzberg
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestDiagnosticProbeConfig(t *testing.T) {
+	config := NewExtractionConfig(
+		WithPdfOptions(WithPdfExtractMetadata(true)),
+		WithOnComplete(func(string, *ExtractionResult, error) {}),
+		WithProgressCallback(func(ProgressEvent) {}),
+	)
+	cfg := diagnosticProbeConfig(config)
+
+	if cfg.OnComplete != nil || cfg.ProgressCallback != nil {
+		t.Error("expected the probe to run without Go-side hooks")
+	}
+	if cfg.PdfOptions.ExtractFontInfo == nil || !*cfg.PdfOptions.ExtractFontInfo {
+		t.Error("expected font info extraction to be enabled")
+	}
+	if cfg.PdfOptions.ExtractMetadata == nil || !*cfg.PdfOptions.ExtractMetadata {
+		t.Error("expected the caller's PDF options to be kept")
+	}
+	if !pagesRequested(cfg) {
+		t.Error("expected page extraction to be enabled")
+	}
+	if config.PdfOptions.ExtractFontInfo != nil || config.Pages != nil || config.OnComplete == nil {
+		t.Error("expected the caller's config to be unchanged")
+	}
+}
+
+func TestDiagnosticReportFill(t *testing.T) {
+	dpi := 150
+	result := &ExtractionResult{
+		MimeType:    "application/pdf",
+		Warnings:    []string{"skipped corrupt image"},
+		PageErrors:  []PageError{{PageNumber: 2, Message: "invalid content stream"}},
+		Fonts:       []FontInfo{{Name: "Helvetica"}},
+		OCRFallback: true,
+		Pages:       []PageContent{{PageNumber: 1, DetectedDPI: &dpi}, {PageNumber: 2}},
+	}
+	report := &DiagnosticReport{}
+	report.fill(result)
+
+	if report.MimeType != "application/pdf" || !report.OCRFallback {
+		t.Errorf("unexpected report: %+v", report)
+	}
+	if len(report.Warnings) != 1 || len(report.PageErrors) != 1 || len(report.Fonts) != 1 {
+		t.Errorf("expected warnings, page errors, and fonts to be copied: %+v", report)
+	}
+	if len(report.DetectedDPI) != 1 || report.DetectedDPI[0] != (PageDPI{PageNumber: 1, DPI: 150}) {
+		t.Errorf("unexpected detected DPI: %+v", report.DetectedDPI)
+	}
+}
+
+func TestStageTimer(t *testing.T) {
+	timer := &stageTimer{}
+	if timer.stages() != nil {
+		t.Error("expected no stages before any event")
+	}
+	timer.record(ProgressStageExtracting)
+	time.Sleep(time.Millisecond)
+	timer.record(ProgressStagePostProcessing)
+	timer.record(ProgressStageComplete)
+
+	stages := timer.stages()
+	if len(stages) != 2 || stages[0].Stage != ProgressStageExtracting || stages[1].Stage != ProgressStagePostProcessing {
+		t.Fatalf("unexpected stages: %+v", stages)
+	}
+	if stages[0].Duration < time.Millisecond {
+		t.Errorf("expected extracting stage to take at least 1ms, got %v", stages[0].Duration)
+	}
+}
+
+func TestExtractWithReport_Failure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing.pdf")
+	result, report, err := ExtractWithReport(path, nil)
+	if err == nil {
+		t.Fatal("expected extraction of a missing file to fail")
+	}
+	if result != nil {
+		t.Error("expected no result")
+	}
+	if report == nil || report.Path != path || report.Error == "" || report.Config != nil {
+		t.Errorf("expected a report describing the failure, got %+v", report)
+	}
+	if report.Probe == nil || report.Probe.Error == "" {
+		t.Errorf("expected the probe to report its failure, got %+v", report.Probe)
+	}
+}
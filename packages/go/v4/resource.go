@@ -0,0 +1,162 @@
+package kreuzberg
+
+import (
+	"io/fs"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ResourceStats describes the resources used by one extraction.
+//
+// Values reported by the native core are used where available. Otherwise the Go
+// binding measures them while it holds the native lock for the call: CPUTimeMs
+// is the process CPU time (user and system) spent during the native call, so it
+// excludes other extractions but includes Go work done concurrently by other
+// goroutines, and TempDiskBytes is the largest size the call's scratch
+// directory reached, sampled every tempDiskSampleInterval. The operating system
+// only reports the peak memory of the whole process, so the Go side fills
+// ProcessPeakMemoryBytes rather than PeakMemoryBytes. CPU time and process peak
+// memory are 0 on platforms without resource accounting, and temp disk usage is
+// only measured when WithTempDir is set. Batch extractions only carry native
+// values.
+type ResourceStats struct {
+	// PeakMemoryBytes is the peak resident memory of the extraction in bytes,
+	// as reported by the native core.
+	PeakMemoryBytes uint64 `json:"peak_memory_bytes"`
+	// ProcessPeakMemoryBytes is the peak resident memory of the whole process
+	// in bytes when the extraction returned. It never decreases, so after one
+	// large document every later result reports at least that document's peak.
+	ProcessPeakMemoryBytes uint64 `json:"process_peak_memory_bytes"`
+	// CPUTimeMs is the CPU time in milliseconds.
+	CPUTimeMs uint64 `json:"cpu_time_ms"`
+	// TempDiskBytes is the peak size of the intermediate files written to the temp dir.
+	TempDiskBytes uint64 `json:"temp_disk_bytes"`
+}
+
+// CPUTime returns CPUTimeMs as a time.Duration.
+func (s *ResourceStats) CPUTime() time.Duration {
+	return time.Duration(s.CPUTimeMs) * time.Millisecond
+}
+
+// tempDiskSampleInterval is how often the scratch directory is measured while
+// a native call runs. The native core removes its intermediate files before
+// returning, so a single measurement afterwards would miss them.
+const tempDiskSampleInterval = 50 * time.Millisecond
+
+// resourceTracker measures one extraction. A nil tracker measures nothing.
+type resourceTracker struct {
+	tempDir  string
+	startCPU time.Duration
+	cpu      time.Duration
+	peak     uint64
+
+	tempBytes atomic.Uint64
+	stop      chan struct{}
+	sampled   sync.WaitGroup
+}
+
+// startResourceTracking prepares to measure an extraction, or returns nil when
+// resource tracking is disabled in config. config is the per-call config whose
+// TempDir is the scratch directory. Measuring starts with begin.
+func startResourceTracking(config *ExtractionConfig) *resourceTracker {
+	if config == nil || config.ResourceTracking == nil || !*config.ResourceTracking {
+		return nil
+	}
+	t := &resourceTracker{}
+	if config.TempDir != nil {
+		t.tempDir = *config.TempDir
+	}
+	return t
+}
+
+// begin starts measuring. Callers invoke it after acquiring ffiMutex so the
+// window covers only their own native call.
+func (t *resourceTracker) begin() {
+	if t == nil {
+		return
+	}
+	t.startCPU, _ = processResourceUsage()
+	if t.tempDir == "" {
+		return
+	}
+
+	t.stop = make(chan struct{})
+	t.sampled.Add(1)
+	go func() {
+		defer t.sampled.Done()
+		ticker := time.NewTicker(tempDiskSampleInterval)
+		defer ticker.Stop()
+		for {
+			t.sampleTempDir()
+			select {
+			case <-t.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// end stops measuring. Callers invoke it before releasing ffiMutex.
+func (t *resourceTracker) end() {
+	if t == nil {
+		return
+	}
+	t.cpu, t.peak = processResourceUsage()
+	if t.stop != nil {
+		close(t.stop)
+		t.sampled.Wait()
+		t.sampleTempDir()
+	}
+}
+
+// sampleTempDir records the current size of the scratch directory if it is
+// the largest seen so far.
+func (t *resourceTracker) sampleTempDir() {
+	size := dirSize(t.tempDir)
+	for {
+		current := t.tempBytes.Load()
+		if size <= current || t.tempBytes.CompareAndSwap(current, size) {
+			return
+		}
+	}
+}
+
+// finish fills the fields of result.ResourceUsage that the native core left
+// empty with the values measured between begin and end.
+func (t *resourceTracker) finish(result *ExtractionResult) {
+	if t == nil || result == nil {
+		return
+	}
+	if result.ResourceUsage == nil {
+		result.ResourceUsage = &ResourceStats{}
+	}
+	stats := result.ResourceUsage
+
+	if stats.CPUTimeMs == 0 && t.cpu > t.startCPU {
+		stats.CPUTimeMs = uint64((t.cpu - t.startCPU) / time.Millisecond)
+	}
+	if stats.ProcessPeakMemoryBytes == 0 {
+		stats.ProcessPeakMemoryBytes = t.peak
+	}
+	if stats.TempDiskBytes == 0 {
+		stats.TempDiskBytes = t.tempBytes.Load()
+	}
+}
+
+// dirSize returns the total size of the regular files below dir.
+func dirSize(dir string) uint64 {
+	var size uint64
+	_ = filepath.WalkDir(dir, func(_ string, entry fs.DirEntry, err error) error {
+		if err != nil || !entry.Type().IsRegular() {
+			return nil
+		}
+		if info, err := entry.Info(); err == nil {
+			size += uint64(info.Size())
+		}
+		return nil
+	})
+	return size
+}
@@ -0,0 +1,10 @@
+//go:build !unix
+
+package kreuzberg
+
+import "time"
+
+// processResourceUsage is not supported on this platform and reports nothing.
+func processResourceUsage() (time.Duration, uint64) {
+	return 0, 0
+}
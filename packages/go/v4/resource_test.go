@@ -0,0 +1,91 @@
+package kreuzberg
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestResourceTrackingDisabled(t *testing.T) {
+	if startResourceTracking(nil) != nil || startResourceTracking(NewExtractionConfig()) != nil {
+		t.Fatal("expected no tracker when resource tracking is disabled")
+	}
+
+	var tracker *resourceTracker
+	result := &ExtractionResult{}
+	tracker.begin()
+	tracker.end()
+	tracker.finish(result)
+	if result.ResourceUsage != nil {
+		t.Error("expected nil tracker to leave ResourceUsage unset")
+	}
+}
+
+func TestResourceTrackerFinish(t *testing.T) {
+	scratch := t.TempDir()
+	if err := os.WriteFile(filepath.Join(scratch, "page-1.png"), make([]byte, 2048), 0o644); err != nil {
+		t.Fatalf("write scratch file: %v", err)
+	}
+	config := NewExtractionConfig(WithResourceTracking(true), WithTempDir(scratch))
+
+	tracker := startResourceTracking(config)
+	if tracker == nil {
+		t.Fatal("expected tracker when resource tracking is enabled")
+	}
+	result := &ExtractionResult{}
+	tracker.begin()
+	tracker.end()
+	tracker.finish(result)
+
+	if result.ResourceUsage == nil {
+		t.Fatal("expected ResourceUsage to be set")
+	}
+	if result.ResourceUsage.TempDiskBytes != 2048 {
+		t.Errorf("expected 2048 temp bytes, got %d", result.ResourceUsage.TempDiskBytes)
+	}
+	if result.ResourceUsage.PeakMemoryBytes != 0 {
+		t.Errorf("expected PeakMemoryBytes to be left to the native core, got %d", result.ResourceUsage.PeakMemoryBytes)
+	}
+}
+
+func TestResourceTrackerSamplesTempDirDuringCall(t *testing.T) {
+	scratch := t.TempDir()
+	config := NewExtractionConfig(WithResourceTracking(true), WithTempDir(scratch))
+	tracker := startResourceTracking(config)
+
+	tracker.begin()
+	path := filepath.Join(scratch, "page-1.png")
+	if err := os.WriteFile(path, make([]byte, 4096), 0o644); err != nil {
+		t.Fatalf("write scratch file: %v", err)
+	}
+	time.Sleep(3 * tempDiskSampleInterval)
+	if err := os.Remove(path); err != nil {
+		t.Fatalf("remove scratch file: %v", err)
+	}
+	tracker.end()
+
+	result := &ExtractionResult{}
+	tracker.finish(result)
+	if result.ResourceUsage.TempDiskBytes != 4096 {
+		t.Errorf("expected files removed before the call returned to be counted, got %d bytes", result.ResourceUsage.TempDiskBytes)
+	}
+}
+
+func TestResourceTrackerKeepsNativeValues(t *testing.T) {
+	config := NewExtractionConfig(WithResourceTracking(true))
+	result := &ExtractionResult{ResourceUsage: &ResourceStats{PeakMemoryBytes: 42, CPUTimeMs: 1500, TempDiskBytes: 7}}
+
+	tracker := startResourceTracking(config)
+	tracker.begin()
+	tracker.end()
+	tracker.finish(result)
+
+	stats := result.ResourceUsage
+	if stats.PeakMemoryBytes != 42 || stats.CPUTimeMs != 1500 || stats.TempDiskBytes != 7 {
+		t.Errorf("expected native values to be kept, got %+v", stats)
+	}
+	if stats.CPUTime() != 1500*time.Millisecond {
+		t.Errorf("unexpected CPU time %v", stats.CPUTime())
+	}
+}
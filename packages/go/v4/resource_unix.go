@@ -0,0 +1,26 @@
+//go:build unix
+
+package kreuzberg
+
+import (
+	"runtime"
+	"syscall"
+	"time"
+)
+
+// processResourceUsage returns the CPU time used by the process so far and its
+// peak resident set size in bytes.
+func processResourceUsage() (time.Duration, uint64) {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_SELF, &usage); err != nil {
+		return 0, 0
+	}
+	cpu := time.Duration(usage.Utime.Nano() + usage.Stime.Nano())
+
+	peak := uint64(usage.Maxrss)
+	if runtime.GOOS != "darwin" && runtime.GOOS != "ios" {
+		// Maxrss is reported in kilobytes everywhere except on Apple platforms.
+		peak *= 1024
+	}
+	return cpu, peak
+}
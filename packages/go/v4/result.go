@@ -47,6 +47,36 @@ func (r *ExtractionResult) GetDetectedLanguage() (string, error) {
 	return "", nil
 }
 
+// Embeddings returns the chunk vectors aligned with r.Chunks, for pushing into a
+// vector store: Embeddings()[i] is the vector of r.Chunks[i]. It returns nil when
+// no chunk carries an embedding.
+func (r *ExtractionResult) Embeddings() [][]float32 {
+	var vectors [][]float32
+	for i, chunk := range r.Chunks {
+		if chunk.Embedding == nil {
+			continue
+		}
+		if vectors == nil {
+			vectors = make([][]float32, len(r.Chunks))
+		}
+		vectors[i] = chunk.Embedding
+	}
+	return vectors
+}
+
+// LowResolutionPages returns the numbers of scanned pages whose detected input
+// DPI is below minDPI, e.g. to route them for rescanning before OCR. Requires
+// page extraction; pages without a detected DPI are skipped.
+func (r *ExtractionResult) LowResolutionPages(minDPI int) []uint64 {
+	var pages []uint64
+	for _, page := range r.Pages {
+		if page.DetectedDPI != nil && *page.DetectedDPI < minDPI {
+			pages = append(pages, page.PageNumber)
+		}
+	}
+	return pages
+}
+
 // MetadataField represents a metadata field with its value and existence status.
 type MetadataField struct {
 	Name   string
@@ -0,0 +1,469 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// decodeResultWithMetadata builds a result the way convertCResult does, from a
+// metadata payload emitted by the Rust core.
+func decodeResultWithMetadata(t *testing.T, metadataJSON string) *ExtractionResult {
+	t.Helper()
+
+	result := &ExtractionResult{}
+	if err := json.Unmarshal([]byte(metadataJSON), &result.Metadata); err != nil {
+		t.Fatalf("failed to decode metadata: %v", err)
+	}
+	if err := result.promoteMetadataFields(); err != nil {
+		t.Fatalf("failed to promote metadata fields: %v", err)
+	}
+	return result
+}
+
+func TestPromoteMetadataFields_TOC(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{
+		"title": "Report",
+		"toc": [
+			{"title": "Introduction", "level": 1, "page_number": 1, "source": "bookmarks"},
+			{"title": "Scope", "level": 2, "source": "headings"}
+		]
+	}`)
+
+	if len(result.TOC) != 2 {
+		t.Fatalf("expected 2 TOC entries, got %d", len(result.TOC))
+	}
+	if result.TOC[0].Source != TOCSourceBookmarks {
+		t.Errorf("expected bookmarks source, got %q", result.TOC[0].Source)
+	}
+	if result.TOC[1].PageNumber != nil {
+		t.Error("expected missing page number to stay nil")
+	}
+	if _, ok := result.Metadata.Additional["toc"]; ok {
+		t.Error("expected toc to be removed from Metadata.Additional")
+	}
+}
+
+func TestPromoteMetadataFields_DetectedType(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"detected_type": "invoice"}`)
+
+	if result.DetectedType != "invoice" {
+		t.Errorf("expected invoice, got %q", result.DetectedType)
+	}
+	if result.Metadata.Additional != nil {
+		t.Errorf("expected no remaining additional fields, got %v", result.Metadata.Additional)
+	}
+}
+
+func TestPromoteMetadataFields_DocumentClassification(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"document_type": "resume", "document_type_confidence": 0.82}`)
+
+	if result.DetectedType != DocumentTypeResume {
+		t.Errorf("expected resume, got %q", result.DetectedType)
+	}
+	if result.DetectedTypeConfidence == nil || *result.DetectedTypeConfidence != 0.82 {
+		t.Error("expected confidence 0.82")
+	}
+}
+
+func TestPromoteMetadataFields_Dates(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"dates": [
+		{"raw": "3. März 2024", "normalized": "2024-03-03T00:00:00Z", "offset": 12}
+	]}`)
+
+	if len(result.Dates) != 1 {
+		t.Fatalf("expected 1 date, got %d", len(result.Dates))
+	}
+	if result.Dates[0].Normalized != "2024-03-03T00:00:00Z" || result.Dates[0].Offset != 12 {
+		t.Errorf("unexpected date: %+v", result.Dates[0])
+	}
+}
+
+func TestPromoteMetadataFields_DroppedLanguageSections(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"dropped_language_sections": [
+		{"language": "de", "page_number": 3, "char_count": 1840}
+	]}`)
+
+	if len(result.DroppedLanguageSections) != 1 {
+		t.Fatalf("expected 1 dropped section, got %d", len(result.DroppedLanguageSections))
+	}
+	dropped := result.DroppedLanguageSections[0]
+	if dropped.Language != "de" || dropped.PageNumber == nil || *dropped.PageNumber != 3 {
+		t.Errorf("unexpected dropped section: %+v", dropped)
+	}
+}
+
+func TestPromoteMetadataFields_Annotations(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"annotations": [
+		{"source_format": "pdf", "kind": "highlight", "content": "check this", "page_number": 2},
+		{"source_format": "docx", "kind": "comment", "content": "typo", "author": "Reviewer"}
+	]}`)
+
+	if len(result.Annotations) != 2 {
+		t.Fatalf("expected 2 annotations, got %d", len(result.Annotations))
+	}
+	if result.Annotations[0].SourceFormat != AnnotationSourcePDF || *result.Annotations[0].PageNumber != 2 {
+		t.Errorf("unexpected PDF annotation: %+v", result.Annotations[0])
+	}
+	if result.Annotations[1].SourceFormat != AnnotationSourceDOCX || *result.Annotations[1].Author != "Reviewer" {
+		t.Errorf("unexpected DOCX annotation: %+v", result.Annotations[1])
+	}
+}
+
+func TestPromoteMetadataFields_Footnotes(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"footnotes": [
+		{"marker": "1", "text": "See Smith (2020).", "reference_page": 4, "reference_offset": 9120, "footnote_page": 4}
+	]}`)
+
+	if len(result.Footnotes) != 1 {
+		t.Fatalf("expected 1 footnote, got %d", len(result.Footnotes))
+	}
+	footnote := result.Footnotes[0]
+	if footnote.Marker != "1" || footnote.ReferenceOffset == nil || *footnote.ReferenceOffset != 9120 {
+		t.Errorf("unexpected footnote: %+v", footnote)
+	}
+}
+
+func TestPromoteMetadataFields_CrossReferences(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"cross_references": [
+		{"text": "Section 3.2", "offset": 120, "target_type": "section", "label": "3.2", "resolved": true, "target_title": "Method", "target_page": 5},
+		{"text": "Figure 9", "offset": 480, "target_type": "figure", "label": "9", "resolved": false}
+	]}`)
+
+	if len(result.CrossReferences) != 2 {
+		t.Fatalf("expected 2 cross references, got %d", len(result.CrossReferences))
+	}
+	if ref := result.CrossReferences[0]; !ref.Resolved || ref.TargetType != CrossRefTargetSection || *ref.TargetPage != 5 {
+		t.Errorf("unexpected resolved reference: %+v", ref)
+	}
+	if ref := result.CrossReferences[1]; ref.Resolved || ref.TargetPage != nil {
+		t.Errorf("unexpected unresolved reference: %+v", ref)
+	}
+}
+
+func TestPromoteMetadataFields_ConfidenceHeatmap(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"confidence_heatmap": [
+		{"page_number": 1, "rows": 2, "columns": 2,
+		 "confidence": [[0.95, 0.0], [0.41, 0.88]],
+		 "char_counts": [[120, 0], [35, 60]]}
+	]}`)
+
+	if len(result.ConfidenceHeatmap) != 1 {
+		t.Fatalf("expected 1 heatmap, got %d", len(result.ConfidenceHeatmap))
+	}
+	heatmap := result.ConfidenceHeatmap[0]
+	if value, ok := heatmap.At(1, 0); !ok || value != 0.41 {
+		t.Errorf("expected confidence 0.41 at (1, 0), got %v (%v)", value, ok)
+	}
+	if _, ok := heatmap.At(0, 1); ok {
+		t.Error("expected empty region to report no confidence")
+	}
+	if _, ok := heatmap.At(2, 0); ok {
+		t.Error("expected out-of-range region to report no confidence")
+	}
+}
+
+func TestPromoteMetadataFields_Fonts(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"fonts": [
+		{"name": "Helvetica", "type": "Type1", "embedded": false, "subset": false, "pages": [1, 2]},
+		{"name": "Calibri-Bold", "type": "TrueType", "embedded": true, "subset": true, "pages": [2]}
+	]}`)
+
+	if len(result.Fonts) != 2 {
+		t.Fatalf("expected 2 fonts, got %d", len(result.Fonts))
+	}
+	if font := result.Fonts[0]; font.Embedded || len(font.Pages) != 2 {
+		t.Errorf("unexpected font: %+v", font)
+	}
+	if font := result.Fonts[1]; !font.Embedded || !font.Subset || font.Type != "TrueType" {
+		t.Errorf("unexpected font: %+v", font)
+	}
+}
+
+func TestPromoteMetadataFields_PdfCompliance(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"pdf_compliance": {
+		"pdf_version": "1.7", "pdfa_conformance": "2b", "color_spaces": ["DeviceRGB", "ICCBased"],
+		"has_output_intent": true, "all_fonts_embedded": true, "has_javascript": false
+	}}`)
+
+	compliance := result.PdfCompliance
+	if compliance == nil {
+		t.Fatal("expected PDF compliance to be promoted")
+	}
+	if compliance.PdfVersion != "1.7" || compliance.PdfAConformance == nil || *compliance.PdfAConformance != "2b" {
+		t.Errorf("unexpected compliance: %+v", compliance)
+	}
+	if !compliance.AllFontsEmbedded || compliance.HasJavaScript || len(compliance.ColorSpaces) != 2 {
+		t.Errorf("unexpected compliance signals: %+v", compliance)
+	}
+}
+
+func TestPromoteMetadataFields_ScannedDetection(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"is_scanned": true, "scanned_confidence": 0.93}`)
+
+	if !result.IsScanned {
+		t.Error("expected document to be classified as scanned")
+	}
+	if result.ScannedConfidence == nil || *result.ScannedConfidence != 0.93 {
+		t.Errorf("unexpected confidence: %v", result.ScannedConfidence)
+	}
+
+	digital := decodeResultWithMetadata(t, `{"is_scanned": false, "scanned_confidence": 0.99}`)
+	if digital.IsScanned || digital.ScannedConfidence == nil {
+		t.Errorf("unexpected born-digital classification: %v, %v", digital.IsScanned, digital.ScannedConfidence)
+	}
+}
+
+func TestPromoteMetadataFields_OCRRegions(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"ocr_regions": [
+		{"page_number": 1, "bounding_box": {"x0": 12, "y0": 40, "x1": 96, "y1": 58}, "text": "Invoice", "backend": "paddleocr", "confidence": 0.97}
+	]}`)
+
+	if len(result.OCRRegions) != 1 {
+		t.Fatalf("expected 1 OCR region, got %d", len(result.OCRRegions))
+	}
+	if region := result.OCRRegions[0]; region.Backend != "paddleocr" || region.BoundingBox.X1 != 96 {
+		t.Errorf("unexpected OCR region: %+v", region)
+	}
+}
+
+func TestPromoteMetadataFields_Revisions(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"revisions": [
+		{"type": "insert", "author": "A. Counsel", "timestamp": "2024-03-01T10:00:00Z", "text": "net 30"},
+		{"type": "delete", "text": "net 60"}
+	]}`)
+
+	if len(result.Revisions) != 2 {
+		t.Fatalf("expected 2 revisions, got %d", len(result.Revisions))
+	}
+	if rev := result.Revisions[0]; rev.Type != RevisionInsert || rev.Author == nil || *rev.Author != "A. Counsel" || rev.Text != "net 30" {
+		t.Errorf("unexpected revision: %+v", rev)
+	}
+	if rev := result.Revisions[1]; rev.Type != RevisionDelete || rev.Author != nil || rev.Timestamp != nil {
+		t.Errorf("unexpected revision: %+v", rev)
+	}
+}
+
+func TestPromoteMetadataFields_Metrics(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"metrics": {"total_ms": 1250.5, "ocr_ms": 900, "parse_ms": 120, "page_count": 4}}`)
+
+	metrics := result.Metrics
+	if metrics == nil {
+		t.Fatal("expected metrics to be promoted")
+	}
+	if metrics.TotalDuration != 1250500*time.Microsecond || metrics.OCRDuration != 900*time.Millisecond || metrics.ParseDuration != 120*time.Millisecond {
+		t.Errorf("unexpected durations: %+v", metrics)
+	}
+	if metrics.PageCount != 4 {
+		t.Errorf("expected 4 pages, got %d", metrics.PageCount)
+	}
+
+	data, err := json.Marshal(metrics)
+	if err != nil {
+		t.Fatalf("marshal metrics: %v", err)
+	}
+	var roundTrip ExtractionMetrics
+	if err := json.Unmarshal(data, &roundTrip); err != nil || roundTrip != *metrics {
+		t.Errorf("expected metrics to round-trip, got %+v (%v)", roundTrip, err)
+	}
+}
+
+func TestPromoteMetadataFields_Diagnostics(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"warnings": ["skipped corrupt image"], "page_errors": [{"page_number": 3, "message": "invalid content stream"}], "ocr_fallback": true}`)
+
+	if len(result.Warnings) != 1 || result.Warnings[0] != "skipped corrupt image" {
+		t.Errorf("unexpected warnings: %v", result.Warnings)
+	}
+	if len(result.PageErrors) != 1 || result.PageErrors[0].PageNumber != 3 || result.PageErrors[0].Message != "invalid content stream" {
+		t.Errorf("unexpected page errors: %+v", result.PageErrors)
+	}
+	if !result.OCRFallback {
+		t.Error("expected OCR fallback to be promoted")
+	}
+}
+
+func TestPromoteMetadataFields_LanguageConfidences(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"language_confidences": [{"code": "fr", "confidence": 0.2}, {"code": "en", "confidence": 0.7}, {"code": "de", "confidence": 0.1}]}`)
+
+	codes := make([]string, len(result.LanguageConfidences))
+	for i, language := range result.LanguageConfidences {
+		codes[i] = language.Code
+	}
+	if strings.Join(codes, ",") != "en,fr,de" {
+		t.Fatalf("expected languages sorted by confidence, got %v", codes)
+	}
+
+	if _, err := finalizeResult(result, NewExtractionConfig(WithLanguageDetection(WithDetectMultiple(true)))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.LanguageConfidences) != 3 {
+		t.Errorf("expected every language with DetectMultiple, got %+v", result.LanguageConfidences)
+	}
+	if _, err := finalizeResult(result, NewExtractionConfig()); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.LanguageConfidences) != 1 || result.LanguageConfidences[0].Code != "en" {
+		t.Errorf("expected only the top language, got %+v", result.LanguageConfidences)
+	}
+
+	result = decodeResultWithMetadata(t, `{"language_confidences": [{"code": "fr", "confidence": 0.2}, {"code": "en", "confidence": 0.7}]}`)
+	if _, err := finalizeResult(result, nil); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.LanguageConfidences) != 1 || result.LanguageConfidences[0].Code != "en" {
+		t.Errorf("expected a nil config to keep only the top language, got %+v", result.LanguageConfidences)
+	}
+}
+
+func TestPromoteMetadataFields_EmbeddingCache(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"embedding_cache": {"hits": 3, "misses": 1}}`)
+
+	if result.EmbeddingCache == nil || result.EmbeddingCache.Hits != 3 || result.EmbeddingCache.Misses != 1 {
+		t.Fatalf("unexpected embedding cache stats: %+v", result.EmbeddingCache)
+	}
+	if rate := result.EmbeddingCache.HitRate(); rate != 0.75 {
+		t.Errorf("expected hit rate 0.75, got %v", rate)
+	}
+	var empty *EmbeddingCacheStats
+	if empty.HitRate() != 0 {
+		t.Error("expected nil stats to have a zero hit rate")
+	}
+}
+
+func TestPromoteMetadataFields_Keywords(t *testing.T) {
+	newResult := func() *ExtractionResult {
+		return decodeResultWithMetadata(t, `{"keywords": ["report"], "extracted_keywords": [{"text": "cloud", "score": 0.4}, {"text": "machine learning", "score": 0.9}, {"text": "data", "score": 0.1}]}`)
+	}
+
+	result := newResult()
+	if len(result.Keywords) != 3 || result.Keywords[0].Text != "machine learning" || result.Keywords[2].Text != "data" {
+		t.Fatalf("expected keywords sorted by score, got %+v", result.Keywords)
+	}
+	if len(result.Metadata.Keywords) != 1 || result.Metadata.Keywords[0] != "report" {
+		t.Errorf("expected document keywords to stay in metadata, got %v", result.Metadata.Keywords)
+	}
+
+	if _, err := finalizeResult(result, NewExtractionConfig(WithKeywords(WithKeywordMinScore(0.3)))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.Keywords) != 2 {
+		t.Errorf("expected keywords below MinScore to be dropped, got %+v", result.Keywords)
+	}
+
+	result = newResult()
+	if _, err := finalizeResult(result, NewExtractionConfig(WithKeywords(WithMaxKeywords(1), WithKeywordMinScore(0)))); err != nil {
+		t.Fatalf("finalizeResult failed: %v", err)
+	}
+	if len(result.Keywords) != 1 || result.Keywords[0].Text != "machine learning" {
+		t.Errorf("expected only the top keyword, got %+v", result.Keywords)
+	}
+}
+
+func TestPromoteMetadataFields_RubyAnnotations(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"ruby_annotations": [{"base": "漢字", "ruby": "かんじ", "page_number": 2, "byte_start": 3, "byte_end": 9}]}`)
+	want := []RubyAnnotation{{Base: "漢字", Ruby: "かんじ", PageNumber: 2, ByteStart: 3, ByteEnd: 9}}
+	if !reflect.DeepEqual(result.RubyAnnotations, want) {
+		t.Errorf("RubyAnnotations = %+v, want %+v", result.RubyAnnotations, want)
+	}
+	if _, ok := result.Metadata.Additional["ruby_annotations"]; ok {
+		t.Error("expected ruby_annotations to be removed from Additional")
+	}
+
+	config := NewExtractionConfig(WithRubyHandling(RubyHandlingSeparate))
+	if err := config.Validate(); err != nil {
+		t.Errorf("expected valid config, got %v", err)
+	}
+	if err := NewExtractionConfig(WithRubyHandling("furigana")).Validate(); err == nil {
+		t.Error("expected error for invalid ruby handling mode")
+	}
+}
+
+func TestPromoteMetadataFields_Charts(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"charts": [{"page_number": 3, "type": "bar", "title": "Revenue", "labels": ["2023", "2024"], "series": [{"name": "EU", "values": [1.5, null]}]}]}`)
+	if len(result.Charts) != 1 {
+		t.Fatalf("expected one chart, got %+v", result.Charts)
+	}
+	chart := result.Charts[0]
+	if chart.PageNumber != 3 || chart.Type != ChartTypeBar || chart.Title == nil || *chart.Title != "Revenue" {
+		t.Errorf("unexpected chart: %+v", chart)
+	}
+	if !reflect.DeepEqual(chart.Labels, []string{"2023", "2024"}) || len(chart.Series) != 1 {
+		t.Fatalf("unexpected labels or series: %+v", chart)
+	}
+	values := chart.Series[0].Values
+	if chart.Series[0].Name != "EU" || len(values) != 2 || values[0] == nil || *values[0] != 1.5 || values[1] != nil {
+		t.Errorf("unexpected series: %+v", chart.Series[0])
+	}
+}
+
+func TestPromoteMetadataFields_XMP(t *testing.T) {
+	packet := `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description rdf:about="" xmlns:dcterms="http://purl.org/dc/elements/1.1/"
+    xmlns:xmp="http://ns.adobe.com/xap/1.0/" xmlns:xmpRights="http://ns.adobe.com/xap/1.0/rights/"
+    xmlns:Iptc4xmpCore="http://iptc.org/std/Iptc4xmpCore/1.0/xmlns/"
+    xmp:CreatorTool="Writer" xmpRights:Marked="True">
+   <dcterms:title><rdf:Alt><rdf:li xml:lang="de">Bericht</rdf:li><rdf:li xml:lang="x-default">Report</rdf:li></rdf:Alt></dcterms:title>
+   <dcterms:creator><rdf:Seq><rdf:li>Ada</rdf:li><rdf:li>Grace</rdf:li></rdf:Seq></dcterms:creator>
+   <xmpRights:WebStatement rdf:resource="https://example.com/license"/>
+   <Iptc4xmpCore:CreatorContactInfo rdf:parseType="Resource">
+    <Iptc4xmpCore:CiEmailWork>ada@example.com</Iptc4xmpCore:CiEmailWork>
+   </Iptc4xmpCore:CreatorContactInfo>
+  </rdf:Description>
+ </rdf:RDF>
+</x:xmpmeta>
+<?xpacket end="w"?>`
+	metadata, err := json.Marshal(map[string]string{"xmp_packet": packet})
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := decodeResultWithMetadata(t, string(metadata))
+	if result.XMPPacket != packet {
+		t.Errorf("expected raw packet to be kept, got %q", result.XMPPacket)
+	}
+	want := map[string]string{
+		"dc:title":               "Report",
+		"dc:creator":             "Ada; Grace",
+		"xmp:CreatorTool":        "Writer",
+		"xmpRights:Marked":       "True",
+		"xmpRights:WebStatement": "https://example.com/license",
+		"Iptc4xmpCore:CreatorContactInfo/Iptc4xmpCore:CiEmailWork": "ada@example.com",
+	}
+	if !reflect.DeepEqual(result.XMP, want) {
+		t.Errorf("unexpected XMP properties:\n got %v\nwant %v", result.XMP, want)
+	}
+}
+
+func TestPromoteMetadataFields_XMPMalformed(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"xmp_packet": "<x:xmpmeta><rdf:RDF>"}`)
+	if result.XMP != nil {
+		t.Errorf("expected no XMP properties, got %v", result.XMP)
+	}
+	if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "XMP") {
+		t.Errorf("expected a warning for the malformed packet, got %v", result.Warnings)
+	}
+}
+
+func TestPromoteMetadataFields_Absent(t *testing.T) {
+	result := decodeResultWithMetadata(t, `{"title": "Report", "custom": 1}`)
+
+	if result.TOC != nil {
+		t.Error("expected TOC to stay nil when not reported")
+	}
+	if _, ok := result.Metadata.Additional["custom"]; !ok {
+		t.Error("expected unrelated additional fields to be preserved")
+	}
+}
+
+func TestPromoteMetadataFields_InvalidPayload(t *testing.T) {
+	result := &ExtractionResult{}
+	if err := json.Unmarshal([]byte(`{"toc": "not a list"}`), &result.Metadata); err != nil {
+		t.Fatalf("failed to decode metadata: %v", err)
+	}
+	if err := result.promoteMetadataFields(); err == nil {
+		t.Error("expected an error for a malformed toc payload")
+	}
+}
@@ -0,0 +1,256 @@
+package kreuzberg
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// defaultRecommendedDPI is suggested when no font sizes could be measured.
+	defaultRecommendedDPI = 300
+	// minRecommendedDPI and maxRecommendedDPI bound the suggested rendering DPI.
+	minRecommendedDPI = 150
+	maxRecommendedDPI = 600
+	// targetGlyphHeightPx is the rendered body text height that OCR engines
+	// recognize most reliably.
+	targetGlyphHeightPx = 30
+)
+
+// SamplingReport describes a result produced by SampleDocument.
+type SamplingReport struct {
+	// TotalPages is the number of pages in the document.
+	TotalPages uint64 `json:"total_pages"`
+	// SampledPages lists the 1-indexed pages that were extracted.
+	SampledPages []uint64 `json:"sampled_pages"`
+	// MedianFontSize is the median font size in points across the sampled text
+	// blocks, if font information was available.
+	MedianFontSize *float64 `json:"median_font_size,omitempty"`
+	// RecommendedDPI is the suggested OCR rendering resolution.
+	RecommendedDPI int `json:"recommended_dpi"`
+	// SuggestedConfig is the input config with the recommended settings applied.
+	SuggestedConfig *ExtractionConfig `json:"suggested_config,omitempty"`
+}
+
+// SampleDocument extracts n evenly spaced pages from the document at path and
+// reports them in result.Pages, together with a result.Sampling report that
+// recommends an OCR rendering DPI based on the font sizes of the PDF hierarchy
+// blocks, or defaultRecommendedDPI when none were measured. Use the suggested
+// config to process the whole document:
+//
+//	sample, err := kreuzberg.SampleDocument("scan.pdf", 5, config)
+//	if err != nil {
+//		return err
+//	}
+//	result, err := kreuzberg.ExtractFileSync("scan.pdf", sample.Sampling.SuggestedConfig)
+//
+// Documents with n or fewer pages are extracted in full. The caller's config is
+// not modified.
+func SampleDocument(path string, n int, config *ExtractionConfig) (*ExtractionResult, error) {
+	if n < 1 {
+		return nil, newValidationErrorWithContext("sample size must be at least 1", nil, ErrorCodeValidation, nil)
+	}
+
+	probe, err := ExtractFileSync(path, sampleConfig(config, []uint64{1}))
+	if err != nil {
+		return nil, err
+	}
+	total := uint64(len(probe.Pages))
+	if probe.Metadata.Pages != nil && probe.Metadata.Pages.TotalCount > 0 {
+		total = probe.Metadata.Pages.TotalCount
+	}
+
+	// The probe already extracted page 1, which every sample starts with.
+	pages := samplePageNumbers(total, n)
+	result := probe
+	if len(pages) > 1 {
+		rest, err := ExtractFileSync(path, sampleConfig(config, pages[1:]))
+		if err != nil {
+			return nil, err
+		}
+		result = mergeSampleResults(probe, rest)
+	}
+
+	report := &SamplingReport{
+		TotalPages:     total,
+		SampledPages:   pages,
+		RecommendedDPI: defaultRecommendedDPI,
+	}
+	if median, ok := medianFontSize(result.Pages); ok {
+		report.MedianFontSize = &median
+		report.RecommendedDPI = recommendDPI(median)
+	}
+	report.SuggestedConfig = suggestConfig(config, report.RecommendedDPI)
+	result.Sampling = report
+	return result, nil
+}
+
+// mergeSampleResults appends the pages, content, tables, and images of rest, the
+// extraction of the later sampled pages, to first, the extraction of page 1.
+// Pages of rest that first already holds are dropped, so a native core that
+// returns pages outside the selection does not duplicate them.
+func mergeSampleResults(first, rest *ExtractionResult) *ExtractionResult {
+	seen := make(map[uint64]bool, len(first.Pages))
+	for _, page := range first.Pages {
+		seen[page.PageNumber] = true
+	}
+	dropPages(rest, func(page uint64) bool { return seen[page] })
+
+	first.Pages = append(first.Pages, rest.Pages...)
+	first.Tables = append(first.Tables, rest.Tables...)
+	first.Images = append(first.Images, rest.Images...)
+	first.ElapsedTime += rest.ElapsedTime
+	switch {
+	case first.Content == "":
+		first.Content = rest.Content
+	case rest.Content != "":
+		first.Content += "\n\n" + rest.Content
+	}
+	return first
+}
+
+// dropPages removes the pages for which drop returns true from result, together
+// with the tables and images found on them, and rebuilds result.Content from the
+// remaining pages. Results without per-page content are left unchanged.
+func dropPages(result *ExtractionResult, drop func(page uint64) bool) {
+	dropped := func(page PageContent) bool { return drop(page.PageNumber) }
+	if !slices.ContainsFunc(result.Pages, dropped) {
+		return
+	}
+	result.Pages = slices.DeleteFunc(result.Pages, dropped)
+	result.Tables = slices.DeleteFunc(result.Tables, func(table Table) bool {
+		return table.PageNumber > 0 && drop(uint64(table.PageNumber))
+	})
+	result.Images = slices.DeleteFunc(result.Images, func(image ExtractedImage) bool {
+		return image.PageNumber != nil && drop(*image.PageNumber)
+	})
+	contents := make([]string, len(result.Pages))
+	for i, page := range result.Pages {
+		contents[i] = page.Content
+	}
+	result.Content = strings.Join(contents, "\n\n")
+}
+
+// samplingConfig returns a copy of config that extracts only the given pages.
+func samplingConfig(config *ExtractionConfig, pages []uint64) *ExtractionConfig {
+	cfg := cloneConfig(config)
+	if cfg.Pages == nil {
+		cfg.Pages = &PageConfig{}
+	}
+	cfg.Pages.ExtractPages = BoolPtr(true)
+	cfg.Pages.PageNumbers = pages
+	return cfg
+}
+
+// sampleConfig returns the config of a SampleDocument extraction: a
+// samplingConfig with PDF hierarchy extraction enabled, which reports the font
+// sizes measured by medianFontSize.
+func sampleConfig(config *ExtractionConfig, pages []uint64) *ExtractionConfig {
+	cfg := samplingConfig(config, pages)
+	if cfg.PdfOptions == nil {
+		cfg.PdfOptions = &PdfConfig{}
+	}
+	if cfg.PdfOptions.Hierarchy == nil {
+		cfg.PdfOptions.Hierarchy = &HierarchyConfig{}
+	}
+	cfg.PdfOptions.Hierarchy.Enabled = BoolPtr(true)
+	return cfg
+}
+
+// samplePageNumbers returns up to n evenly spaced 1-indexed page numbers out of
+// total pages, always including the first and last page.
+func samplePageNumbers(total uint64, n int) []uint64 {
+	if total == 0 {
+		return []uint64{1}
+	}
+	if uint64(n) >= total {
+		pages := make([]uint64, total)
+		for i := range pages {
+			pages[i] = uint64(i) + 1
+		}
+		return pages
+	}
+	if n == 1 {
+		return []uint64{1}
+	}
+
+	pages := make([]uint64, 0, n)
+	step := float64(total-1) / float64(n-1)
+	for i := 0; i < n; i++ {
+		page := uint64(math.Round(float64(i)*step)) + 1
+		if len(pages) == 0 || pages[len(pages)-1] != page {
+			pages = append(pages, page)
+		}
+	}
+	return pages
+}
+
+// medianFontSize returns the median font size of the hierarchy blocks in pages.
+func medianFontSize(pages []PageContent) (float64, bool) {
+	var sizes []float64
+	for _, page := range pages {
+		if page.Hierarchy == nil {
+			continue
+		}
+		for _, block := range page.Hierarchy.Blocks {
+			if block.FontSize > 0 {
+				sizes = append(sizes, float64(block.FontSize))
+			}
+		}
+	}
+	if len(sizes) == 0 {
+		return 0, false
+	}
+
+	sort.Float64s(sizes)
+	mid := len(sizes) / 2
+	if len(sizes)%2 == 0 {
+		return (sizes[mid-1] + sizes[mid]) / 2, true
+	}
+	return sizes[mid], true
+}
+
+// recommendDPI returns the rendering DPI at which text of the given point size
+// reaches targetGlyphHeightPx, rounded to a multiple of 50 and clamped to a
+// practical range.
+func recommendDPI(fontSize float64) int {
+	dpi := targetGlyphHeightPx * 72 / fontSize
+	rounded := int(math.Round(dpi/50)) * 50
+	return min(max(rounded, minRecommendedDPI), maxRecommendedDPI)
+}
+
+// suggestConfig returns a copy of config with the recommended OCR DPI applied.
+func suggestConfig(config *ExtractionConfig, dpi int) *ExtractionConfig {
+	cfg := cloneConfig(config)
+	if cfg.OCR == nil {
+		cfg.OCR = &OCRConfig{}
+	}
+	if cfg.OCR.Tesseract == nil {
+		cfg.OCR.Tesseract = &TesseractConfig{}
+	}
+	if cfg.OCR.Tesseract.Preprocessing == nil {
+		cfg.OCR.Tesseract.Preprocessing = &ImagePreprocessingConfig{}
+	}
+	cfg.OCR.Tesseract.Preprocessing.TargetDPI = &dpi
+	return cfg
+}
+
+// cloneConfig returns a deep copy of config, or an empty config when config is nil.
+// Go-side callbacks are carried over to the copy.
+func cloneConfig(config *ExtractionConfig) *ExtractionConfig {
+	clone := &ExtractionConfig{}
+	if config == nil {
+		return clone
+	}
+	if data, err := json.Marshal(config); err == nil {
+		_ = json.Unmarshal(data, clone)
+	}
+	clone.ContentCallback = config.ContentCallback
+	clone.OnComplete = config.OnComplete
+	clone.MaxReaderSize = config.MaxReaderSize
+	clone.ContentValidator = config.ContentValidator
+	clone.ProgressCallback = config.ProgressCallback
+	return clone
+}
@@ -0,0 +1,158 @@
+package kreuzberg
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSamplePageNumbers(t *testing.T) {
+	cases := []struct {
+		total uint64
+		n     int
+		want  []uint64
+	}{
+		{2000, 5, []uint64{1, 501, 1001, 1500, 2000}},
+		{10, 1, []uint64{1}},
+		{3, 5, []uint64{1, 2, 3}},
+		{4, 3, []uint64{1, 3, 4}},
+		{0, 3, []uint64{1}},
+	}
+	for _, tc := range cases {
+		if got := samplePageNumbers(tc.total, tc.n); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("samplePageNumbers(%d, %d) = %v, want %v", tc.total, tc.n, got, tc.want)
+		}
+	}
+}
+
+func TestRecommendDPI(t *testing.T) {
+	cases := map[float64]int{
+		12: 200,
+		10: 200,
+		8:  250,
+		6:  350,
+		3:  600,
+		24: 150,
+	}
+	for size, want := range cases {
+		if got := recommendDPI(size); got != want {
+			t.Errorf("recommendDPI(%v) = %d, want %d", size, got, want)
+		}
+	}
+}
+
+func TestMedianFontSize(t *testing.T) {
+	pages := []PageContent{
+		{Hierarchy: &PageHierarchy{Blocks: []HierarchicalBlock{{FontSize: 10}, {FontSize: 24}}}},
+		{Hierarchy: &PageHierarchy{Blocks: []HierarchicalBlock{{FontSize: 9}, {FontSize: 11}}}},
+		{},
+	}
+	if median, ok := medianFontSize(pages); !ok || median != 10.5 {
+		t.Errorf("expected median 10.5, got %v (%v)", median, ok)
+	}
+	if _, ok := medianFontSize(nil); ok {
+		t.Error("expected no median without font information")
+	}
+}
+
+func TestSuggestConfig_DoesNotMutateInput(t *testing.T) {
+	config := NewExtractionConfig(WithOCR(WithOCRBackend("tesseract")))
+
+	suggested := suggestConfig(config, 250)
+	if dpi := suggested.OCR.Tesseract.Preprocessing.TargetDPI; dpi == nil || *dpi != 250 {
+		t.Errorf("expected suggested target DPI 250, got %v", dpi)
+	}
+	if suggested.OCR.Backend != "tesseract" {
+		t.Error("expected suggested config to keep the OCR backend")
+	}
+	if config.OCR.Tesseract != nil {
+		t.Error("caller config must not be mutated")
+	}
+}
+
+func TestSamplingConfig(t *testing.T) {
+	config := NewExtractionConfig(WithPages(WithInsertPageMarkers(true)))
+
+	cfg := samplingConfig(config, []uint64{1, 50, 100})
+	if !pagesRequested(cfg) || !reflect.DeepEqual(cfg.Pages.PageNumbers, []uint64{1, 50, 100}) {
+		t.Errorf("unexpected sampling page config: %+v", cfg.Pages)
+	}
+	if cfg.Pages.InsertPageMarkers == nil || !*cfg.Pages.InsertPageMarkers {
+		t.Error("expected existing page options to be preserved")
+	}
+	if config.Pages.PageNumbers != nil {
+		t.Error("caller config must not be mutated")
+	}
+}
+
+func TestSampleConfigEnablesHierarchy(t *testing.T) {
+	config := NewExtractionConfig(WithPdfOptions(WithPdfHierarchy(WithKClusters(3))))
+
+	cfg := sampleConfig(config, []uint64{1})
+	hierarchy := cfg.PdfOptions.Hierarchy
+	if hierarchy == nil || hierarchy.Enabled == nil || !*hierarchy.Enabled {
+		t.Fatalf("expected hierarchy extraction to be enabled, got %+v", hierarchy)
+	}
+	if hierarchy.KClusters == nil || *hierarchy.KClusters != 3 {
+		t.Error("expected existing hierarchy options to be preserved")
+	}
+	if !pagesRequested(cfg) {
+		t.Error("expected page extraction to be enabled")
+	}
+	if config.PdfOptions.Hierarchy.Enabled != nil {
+		t.Error("caller config must not be mutated")
+	}
+}
+
+func TestSampleDocument_InvalidSize(t *testing.T) {
+	if _, err := SampleDocument("doc.pdf", 0, nil); err == nil {
+		t.Error("expected error for sample size 0")
+	} else if _, ok := err.(*ValidationError); !ok {
+		t.Errorf("expected ValidationError, got %T", err)
+	}
+}
+
+func TestMergeSampleResults(t *testing.T) {
+	first := &ExtractionResult{
+		Content: "one",
+		Pages:   []PageContent{{PageNumber: 1, Content: "one"}},
+		Tables:  []Table{{PageNumber: 1}},
+	}
+	rest := &ExtractionResult{
+		Content: "five\n\nnine",
+		Pages:   []PageContent{{PageNumber: 5, Content: "five"}, {PageNumber: 9, Content: "nine"}},
+	}
+
+	merged := mergeSampleResults(first, rest)
+	if len(merged.Pages) != 3 || merged.Pages[0].PageNumber != 1 || merged.Pages[2].PageNumber != 9 {
+		t.Errorf("expected pages 1, 5, and 9, got %+v", merged.Pages)
+	}
+	if merged.Content != "one\n\nfive\n\nnine" {
+		t.Errorf("unexpected content %q", merged.Content)
+	}
+	if len(merged.Tables) != 1 {
+		t.Errorf("expected the first page's table to be kept, got %d", len(merged.Tables))
+	}
+}
+
+func TestMergeSampleResults_DropsPagesAlreadyExtracted(t *testing.T) {
+	first := &ExtractionResult{
+		Content: "one",
+		Pages:   []PageContent{{PageNumber: 1, Content: "one"}},
+	}
+	rest := &ExtractionResult{
+		Content: "one\n\nfive",
+		Pages:   []PageContent{{PageNumber: 1, Content: "one"}, {PageNumber: 5, Content: "five"}},
+		Tables:  []Table{{PageNumber: 1}, {PageNumber: 5}},
+	}
+
+	merged := mergeSampleResults(first, rest)
+	if len(merged.Pages) != 2 || merged.Pages[1].PageNumber != 5 {
+		t.Errorf("expected pages 1 and 5, got %+v", merged.Pages)
+	}
+	if merged.Content != "one\n\nfive" {
+		t.Errorf("unexpected content %q", merged.Content)
+	}
+	if len(merged.Tables) != 1 || merged.Tables[0].PageNumber != 5 {
+		t.Errorf("expected only the table of page 5, got %+v", merged.Tables)
+	}
+}
@@ -0,0 +1,92 @@
+package kreuzberg
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrShutdown is the cause of the *RuntimeError returned by extractions started
+// after Shutdown was called. Test for it with errors.Is.
+var ErrShutdown = errors.New("kreuzberg: shut down")
+
+// lifecycle tracks in-flight extractions so Shutdown can drain them.
+var lifecycle struct {
+	mu       sync.Mutex
+	shutdown bool
+	active   int
+	// drained is closed when the last in-flight extraction finishes after
+	// Shutdown was called.
+	drained chan struct{}
+}
+
+// beginExtraction registers an in-flight extraction. It fails once Shutdown has
+// been called; otherwise the returned function must be called when the
+// extraction finishes.
+func beginExtraction() (func(), error) {
+	lifecycle.mu.Lock()
+	defer lifecycle.mu.Unlock()
+	if lifecycle.shutdown {
+		return nil, newRuntimeErrorWithContext("extraction rejected after Shutdown", ErrShutdown, ErrorCodeInternal, nil)
+	}
+	lifecycle.active++
+	lockFFILogLevel()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			lifecycle.mu.Lock()
+			defer lifecycle.mu.Unlock()
+			lifecycle.active--
+			if lifecycle.active == 0 && lifecycle.drained != nil {
+				close(lifecycle.drained)
+				lifecycle.drained = nil
+			}
+		})
+	}, nil
+}
+
+// Shutdown stops the package from accepting new extractions and waits for
+// in-flight ones to finish, for use on SIGTERM:
+//
+//	<-sigterm
+//	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+//	defer cancel()
+//	if err := kreuzberg.Shutdown(ctx); err != nil {
+//		log.Printf("extractions still running: %v", err)
+//	}
+//
+// Extractions started after Shutdown, through any of the Extract* and
+// BatchExtract* functions, fail with an error wrapping ErrShutdown. Shutdown
+// returns nil once every in-flight extraction has returned and no FFI call is in
+// progress, or ctx.Err() if ctx is done first; extractions still running keep
+// running. Calling Shutdown again waits for the same extractions.
+//
+// The native library keeps PDFium and its other process-wide state loaded until
+// the process exits; Shutdown guarantees that none of it is in use when it
+// returns nil. Shutdown cannot be undone.
+func Shutdown(ctx context.Context) error {
+	lifecycle.mu.Lock()
+	lifecycle.shutdown = true
+	var drained chan struct{}
+	if lifecycle.active > 0 {
+		if lifecycle.drained == nil {
+			lifecycle.drained = make(chan struct{})
+		}
+		drained = lifecycle.drained
+	}
+	lifecycle.mu.Unlock()
+
+	if drained != nil {
+		select {
+		case <-drained:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	// Wait for FFI calls outside extraction, such as MIME detection, to return.
+	ffiMutex.Lock()
+	defer ffiMutex.Unlock()
+	return nil
+}
@@ -0,0 +1,84 @@
+package kreuzberg
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// resetLifecycle undoes Shutdown after the test so later tests can extract.
+func resetLifecycle(t *testing.T) {
+	t.Helper()
+	t.Cleanup(func() {
+		lifecycle.mu.Lock()
+		defer lifecycle.mu.Unlock()
+		lifecycle.shutdown = false
+		lifecycle.active = 0
+		lifecycle.drained = nil
+	})
+}
+
+func TestShutdownRejectsNewExtractions(t *testing.T) {
+	resetLifecycle(t)
+
+	if err := Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown failed: %v", err)
+	}
+	_, err := ExtractBytesSync([]byte("hello"), "text/plain", nil)
+	if !errors.Is(err, ErrShutdown) {
+		t.Errorf("expected ErrShutdown, got %v", err)
+	}
+	var runtimeErr *RuntimeError
+	if !errors.As(err, &runtimeErr) {
+		t.Errorf("expected RuntimeError, got %T", err)
+	}
+	if _, err := BatchExtractFilesSync([]string{"a.pdf"}, nil); !errors.Is(err, ErrShutdown) {
+		t.Errorf("expected ErrShutdown from batch extraction, got %v", err)
+	}
+}
+
+func TestShutdownWaitsForInFlightExtractions(t *testing.T) {
+	resetLifecycle(t)
+
+	release, err := beginExtraction()
+	if err != nil {
+		t.Fatalf("beginExtraction failed: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- Shutdown(context.Background()) }()
+
+	select {
+	case err := <-done:
+		t.Fatalf("Shutdown returned before the extraction finished: %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	release()
+	release()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Shutdown failed: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Shutdown did not return after the extraction finished")
+	}
+}
+
+func TestShutdownDeadline(t *testing.T) {
+	resetLifecycle(t)
+
+	release, err := beginExtraction()
+	if err != nil {
+		t.Fatalf("beginExtraction failed: %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := Shutdown(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
@@ -1,6 +1,7 @@
 package kreuzberg
 
 import (
+	"encoding/json"
 	"os"
 	"strings"
 	"testing"
@@ -671,3 +672,66 @@ func TestEmptyDocumentTableExtraction(t *testing.T) {
 		t.Logf("Note: Plain text document contains %d tables (unexpected)", len(result.Tables))
 	}
 }
+
+// TestTableHasHeaderDecoding verifies that header inference results are decoded from the native payload.
+func TestTableHasHeaderDecoding(t *testing.T) {
+	var tables []Table
+	payload := `[{"cells":[["Name","Age"],["Alice","30"]],"markdown":"","page_number":1,"has_header":true}]`
+	if err := json.Unmarshal([]byte(payload), &tables); err != nil {
+		t.Fatalf("failed to decode tables: %v", err)
+	}
+	if len(tables) != 1 || !tables[0].HasHeader {
+		t.Fatal("expected HasHeader to be true")
+	}
+
+	config := NewExtractionConfig(WithInferTableHeaders(true))
+	if config.InferTableHeaders == nil || !*config.InferTableHeaders {
+		t.Error("expected InferTableHeaders to be true")
+	}
+}
+
+func TestTableCaptionDecoding(t *testing.T) {
+	var tables []Table
+	payload := `[{"cells":[["Jahr","Umsatz"]],"markdown":"","page_number":2,"has_header":true,"caption":"Tabelle 1: Umsatz nach Jahr"}]`
+	if err := json.Unmarshal([]byte(payload), &tables); err != nil {
+		t.Fatalf("failed to decode tables: %v", err)
+	}
+	if tables[0].Caption == nil || *tables[0].Caption != "Tabelle 1: Umsatz nach Jahr" {
+		t.Errorf("unexpected caption: %v", tables[0].Caption)
+	}
+
+	var image ExtractedImage
+	if err := json.Unmarshal([]byte(`{"format":"png","image_index":0,"is_mask":false}`), &image); err != nil {
+		t.Fatalf("failed to decode image: %v", err)
+	}
+	if image.Caption != nil {
+		t.Error("expected missing caption to stay nil")
+	}
+}
+
+func TestTableRowsDecoding(t *testing.T) {
+	var tables []Table
+	payload := `[{"cells":[["SKU","Qty"]],"markdown":"","page_number":1,"has_header":true,
+		"rows":[[{"text":"SKU","bounding_box":{"x0":10,"y0":20,"x1":90,"y1":44},"confidence":0.97},{"text":"Qty"}]]}]`
+	if err := json.Unmarshal([]byte(payload), &tables); err != nil {
+		t.Fatalf("failed to decode tables: %v", err)
+	}
+	if len(tables[0].Rows) != 1 || len(tables[0].Rows[0]) != 2 {
+		t.Fatalf("unexpected rows: %+v", tables[0].Rows)
+	}
+	cell := tables[0].Rows[0][0]
+	if cell.Text != "SKU" || cell.BoundingBox == nil || cell.BoundingBox.X1 != 90 || *cell.Confidence != 0.97 {
+		t.Errorf("unexpected cell: %+v", cell)
+	}
+	if tables[0].Rows[0][1].Confidence != nil {
+		t.Error("expected missing confidence to stay nil")
+	}
+
+	data, err := json.Marshal(Table{Cells: [][]string{{"a"}}})
+	if err != nil {
+		t.Fatalf("failed to encode table: %v", err)
+	}
+	if strings.Contains(string(data), `"rows"`) {
+		t.Errorf("expected rows to be omitted, got %s", data)
+	}
+}
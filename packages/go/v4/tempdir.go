@@ -0,0 +1,41 @@
+package kreuzberg
+
+import "os"
+
+// scratchDirPattern names the per-call directories created inside the temp dir.
+const scratchDirPattern = "kreuzberg-*"
+
+// prepareTempDir creates a private scratch directory inside config.TempDir for
+// one extraction call and returns a config copy pointing the native core at it.
+// The returned cleanup function removes the directory and everything in it;
+// callers must defer it so intermediate files are removed on error and panic as
+// well.
+//
+// A config without TempDir, including a nil config, is returned unchanged so the
+// native core applies its own defaults.
+func prepareTempDir(config *ExtractionConfig) (*ExtractionConfig, func(), error) {
+	if config == nil || config.TempDir == nil {
+		return config, func() {}, nil
+	}
+
+	dir := *config.TempDir
+	if dir == "" {
+		return nil, nil, newTempDirErrorWithContext(dir, "temp dir cannot be empty", nil, ErrorCodeIo, nil)
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, nil, newTempDirErrorWithContext(dir, "", err, ErrorCodeIo, nil)
+	}
+	if !info.IsDir() {
+		return nil, nil, newTempDirErrorWithContext(dir, "temp dir is not a directory: "+dir, nil, ErrorCodeIo, nil)
+	}
+
+	scratch, err := os.MkdirTemp(dir, scratchDirPattern)
+	if err != nil {
+		return nil, nil, newTempDirErrorWithContext(dir, "", err, ErrorCodeIo, nil)
+	}
+
+	cfg := *config
+	cfg.TempDir = &scratch
+	return &cfg, func() { _ = os.RemoveAll(scratch) }, nil
+}
@@ -0,0 +1,113 @@
+package kreuzberg
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kreuzberg-dev/kreuzberg/packages/go/v4/kreuzbergtest"
+)
+
+func TestPrepareTempDir_NilConfig(t *testing.T) {
+	got, cleanup, err := prepareTempDir(nil)
+	if err != nil {
+		t.Fatalf("prepareTempDir failed: %v", err)
+	}
+	defer cleanup()
+	if got != nil {
+		t.Error("expected nil config to stay nil")
+	}
+}
+
+func TestPrepareTempDir_Unset(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+	config := NewExtractionConfig(WithUseCache(false))
+
+	got, cleanup, err := prepareTempDir(config)
+	if err != nil {
+		t.Fatalf("prepareTempDir failed: %v", err)
+	}
+	defer cleanup()
+	if got != config {
+		t.Error("expected config without TempDir to be passed through")
+	}
+	kreuzbergtest.AssertNoTempLeaks(t, dir)
+}
+
+func TestPrepareTempDir_CleanupOnPanic(t *testing.T) {
+	dir := t.TempDir()
+	config := NewExtractionConfig(WithTempDir(dir))
+
+	func() {
+		defer func() { _ = recover() }()
+
+		got, cleanup, err := prepareTempDir(config)
+		if err != nil {
+			t.Fatalf("prepareTempDir failed: %v", err)
+		}
+		defer cleanup()
+		if err := os.WriteFile(filepath.Join(*got.TempDir, "render.tmp"), []byte("x"), 0o600); err != nil {
+			t.Fatalf("failed to write intermediate file: %v", err)
+		}
+		panic("extraction failed")
+	}()
+
+	kreuzbergtest.AssertNoTempLeaks(t, dir)
+}
+
+func TestPrepareTempDir_ScratchDirRemoved(t *testing.T) {
+	dir := t.TempDir()
+	config := NewExtractionConfig(WithTempDir(dir))
+
+	got, cleanup, err := prepareTempDir(config)
+	if err != nil {
+		t.Fatalf("prepareTempDir failed: %v", err)
+	}
+	scratch := *got.TempDir
+	if filepath.Dir(scratch) != dir {
+		t.Errorf("expected scratch dir inside %s, got %s", dir, scratch)
+	}
+	if *config.TempDir != dir {
+		t.Error("caller config must not be mutated")
+	}
+	if err := os.WriteFile(filepath.Join(scratch, "page-1.png"), []byte("x"), 0o600); err != nil {
+		t.Fatalf("failed to write intermediate file: %v", err)
+	}
+
+	cleanup()
+	if _, err := os.Stat(scratch); !os.IsNotExist(err) {
+		t.Errorf("expected scratch dir to be removed, stat returned %v", err)
+	}
+}
+
+func TestPrepareTempDir_Missing(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "missing")
+	_, _, err := prepareTempDir(NewExtractionConfig(WithTempDir(dir)))
+
+	var tempErr *TempDirError
+	if !errors.As(err, &tempErr) {
+		t.Fatalf("expected TempDirError, got %v", err)
+	}
+	if tempErr.Dir != dir || tempErr.Kind() != ErrorKindIO {
+		t.Errorf("unexpected error details: dir=%q kind=%q", tempErr.Dir, tempErr.Kind())
+	}
+}
+
+func TestPrepareTempDir_NotWritable(t *testing.T) {
+	if os.Geteuid() == 0 {
+		t.Skip("permission checks are bypassed when running as root")
+	}
+	dir := t.TempDir()
+	if err := os.Chmod(dir, 0o500); err != nil {
+		t.Fatalf("failed to chmod temp dir: %v", err)
+	}
+	defer os.Chmod(dir, 0o700)
+
+	_, _, err := prepareTempDir(NewExtractionConfig(WithTempDir(dir)))
+	var tempErr *TempDirError
+	if !errors.As(err, &tempErr) {
+		t.Fatalf("expected TempDirError, got %v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package kreuzberg
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+)
 
 // ExtractionResult mirrors the Rust ExtractionResult struct returned by the core API.
 type ExtractionResult struct {
@@ -14,6 +17,492 @@ type ExtractionResult struct {
 	Pages             []PageContent    `json:"pages,omitempty"`
 	Elements          []Element        `json:"elements,omitempty"`
 	DjotContent       *DjotContent     `json:"djot_content,omitempty"`
+	TOC               []TOCEntry       `json:"toc,omitempty"`
+	// DetectedType is the document type reported by the native core when
+	// classification is enabled, one of the DocumentType* labels.
+	DetectedType DocumentTypeLabel `json:"detected_type,omitempty"`
+	// DetectedTypeConfidence is the classifier's confidence (0.0-1.0) in
+	// DetectedType. Nil when classification was not run.
+	DetectedTypeConfidence *float64 `json:"detected_type_confidence,omitempty"`
+	// Dates lists absolute dates found in Content when date extraction is enabled.
+	Dates []ExtractedDate `json:"dates,omitempty"`
+	// DroppedLanguageSections lists content removed by LanguageDetectionConfig.KeepLanguages.
+	DroppedLanguageSections []DroppedLanguageSection `json:"dropped_language_sections,omitempty"`
+	// StrippedCharCount is the number of characters removed from Content by
+	// WithStripEmoji and WithStripSymbols.
+	StrippedCharCount int `json:"stripped_char_count,omitempty"`
+	// Annotations lists comments and annotations from all supported formats when
+	// comment extraction is enabled.
+	Annotations []Annotation `json:"annotations,omitempty"`
+	// Footnotes lists footnotes linked to their markers when footnote linking is enabled.
+	Footnotes []LinkedFootnote `json:"footnotes,omitempty"`
+	// CrossReferences lists internal references found in Content when cross-reference
+	// resolution is enabled.
+	CrossReferences []CrossRef `json:"cross_references,omitempty"`
+	// Sampling describes the sampled pages and suggested settings for results
+	// produced by SampleDocument.
+	Sampling *SamplingReport `json:"sampling,omitempty"`
+	// ConfidenceHeatmap holds per-region OCR confidence for each OCRed page when
+	// character confidence is enabled.
+	ConfidenceHeatmap []PageConfidenceHeatmap `json:"confidence_heatmap,omitempty"`
+	// Fonts lists the fonts used by the document when font info extraction is enabled.
+	Fonts []FontInfo `json:"fonts,omitempty"`
+	// PdfCompliance holds archival compliance signals when the PDF compliance
+	// check is enabled.
+	PdfCompliance *PdfCompliance `json:"pdf_compliance,omitempty"`
+	// IsScanned reports whether the document was classified as scanned when
+	// scanned detection is enabled.
+	IsScanned bool `json:"is_scanned,omitempty"`
+	// ScannedConfidence is the confidence (0.0-1.0) in the IsScanned classification.
+	// Nil when scanned detection was not run.
+	ScannedConfidence *float64 `json:"scanned_confidence,omitempty"`
+	// OCRRegions records which backend's recognition was kept for each merged
+	// region when several OCR backends are configured.
+	OCRRegions []OCRRegion `json:"ocr_regions,omitempty"`
+	// ResourceUsage reports the resources used by the extraction when resource
+	// tracking is enabled.
+	ResourceUsage *ResourceStats `json:"resource_usage,omitempty"`
+	// RedactedImageCount is the number of images removed by WithRedactImages: the
+	// larger of the number of extracted images dropped and the number of image
+	// references removed from Content.
+	RedactedImageCount int `json:"redacted_image_count,omitempty"`
+	// Revisions lists the tracked changes of a Word document in document order
+	// when DocxConfig.RevisionHistory is enabled.
+	Revisions []Revision `json:"revisions,omitempty"`
+	// Metrics reports per-stage timing when the native core provides it, and is
+	// nil otherwise.
+	Metrics *ExtractionMetrics `json:"metrics,omitempty"`
+	// ElapsedTime is the wall-clock time of the native extraction call as
+	// measured by the Go binding, excluding the wait for the FFI lock. The core
+	// does not time the inputs of a batch call separately, so each result of a
+	// batch gets an even share of the whole call.
+	ElapsedTime time.Duration `json:"elapsed_time_ns,omitempty"`
+	// Warnings lists non-fatal problems the native core reported during extraction,
+	// such as unreadable embedded objects or skipped pages.
+	Warnings []string `json:"warnings,omitempty"`
+	// PageErrors lists pages whose extraction failed while the rest of the document
+	// was extracted.
+	PageErrors []PageError `json:"page_errors,omitempty"`
+	// OCRFallback reports whether OCR was run because the document had no usable
+	// text layer, rather than because OCR was forced.
+	OCRFallback bool `json:"ocr_fallback,omitempty"`
+	// LanguageConfidences lists the languages detected by language detection with
+	// their confidence, highest first. Only the top language is present unless
+	// LanguageDetectionConfig.DetectMultiple is enabled.
+	LanguageConfidences []DetectedLanguage `json:"language_confidences,omitempty"`
+	// EmbeddingCache reports how many chunk embeddings were served from the
+	// embedding cache when EmbeddingConfig.Cache is enabled.
+	EmbeddingCache *EmbeddingCacheStats `json:"embedding_cache,omitempty"`
+	// Keywords lists the keywords found by keyword extraction, highest score first,
+	// limited by KeywordConfig.MaxKeywords and MinScore. Document keywords from
+	// the file's own metadata are in Metadata.Keywords.
+	Keywords []Keyword `json:"extracted_keywords,omitempty"`
+	// Children holds one result per logical document when
+	// WithSplitConcatenatedDocuments is enabled.
+	Children []*ExtractionResult `json:"children,omitempty"`
+	// CacheHit reports whether the native core served the result from its
+	// extraction cache.
+	CacheHit bool `json:"cache_hit,omitempty"`
+	// RubyAnnotations lists the ruby annotations of the document in document
+	// order when ExtractionConfig.RubyHandling is RubyHandlingSeparate.
+	RubyAnnotations []RubyAnnotation `json:"ruby_annotations,omitempty"`
+	// Charts lists the charts whose data was reconstructed when
+	// ExtractionConfig.ChartExtraction is enabled, in document order.
+	Charts []Chart `json:"charts,omitempty"`
+	// ConfusablesNormalized is the number of look-alike letters replaced in
+	// Content when ExtractionConfig.NormalizeConfusables is enabled. Each mixed
+	// word is rewritten in the script of its letters without a look-alike, or the
+	// prevailing script of its line; single-script words are never changed.
+	ConfusablesNormalized int `json:"confusables_normalized,omitempty"`
+	// XMPPacket is the raw XMP packet of the document when
+	// ExtractionConfig.ExtractXMP is enabled and the document embeds one.
+	XMPPacket string `json:"xmp_packet,omitempty"`
+	// XMP holds the properties of XMPPacket keyed by prefixed property name,
+	// such as "dc:creator" or "xmpRights:UsageTerms". Common schemas use their
+	// conventional prefixes: dc, xmp, xmpRights, xmpMM, pdf, photoshop,
+	// Iptc4xmpCore, exif, tiff, and cc. Array values are joined with "; ", and
+	// language alternatives yield their default entry.
+	XMP map[string]string `json:"xmp,omitempty"`
+	// Truncated reports whether Content was truncated to
+	// ExtractionConfig.MaxContentBytes.
+	Truncated bool `json:"truncated,omitempty"`
+	// OriginalContentLength is the length in bytes of Content before it was
+	// truncated, or 0 when it was not.
+	OriginalContentLength int `json:"original_content_length,omitempty"`
+}
+
+// ExtractedDate is an absolute date found in the document content.
+type ExtractedDate struct {
+	// Raw is the matched text as it appears in Content.
+	Raw string `json:"raw"`
+	// Normalized is the date in RFC 3339 format.
+	Normalized string `json:"normalized"`
+	// Offset is the character offset of Raw in Content.
+	Offset uint64 `json:"offset"`
+}
+
+// DroppedLanguageSection describes content removed because its detected language
+// was not in LanguageDetectionConfig.KeepLanguages.
+type DroppedLanguageSection struct {
+	// Language is the ISO 639 code detected for the removed content.
+	Language string `json:"language"`
+	// PageNumber is the 1-indexed page the content was on, if the format has pages.
+	PageNumber *uint64 `json:"page_number,omitempty"`
+	// CharCount is the number of characters removed.
+	CharCount uint64 `json:"char_count"`
+}
+
+// AnnotationSourceFormat identifies the document format an annotation came from.
+type AnnotationSourceFormat string
+
+const (
+	AnnotationSourcePDF  AnnotationSourceFormat = "pdf"
+	AnnotationSourceDOCX AnnotationSourceFormat = "docx"
+	AnnotationSourcePPTX AnnotationSourceFormat = "pptx"
+)
+
+// Annotation is a comment or annotation attached to the document, normalized
+// across source formats.
+type Annotation struct {
+	// SourceFormat is the format the annotation was read from.
+	SourceFormat AnnotationSourceFormat `json:"source_format"`
+	// Kind is the format-specific annotation kind, e.g. "comment", "highlight", or "note".
+	Kind string `json:"kind"`
+	// Content is the annotation text.
+	Content string `json:"content"`
+	// Author is the annotation author, if recorded.
+	Author *string `json:"author,omitempty"`
+	// CreatedAt is the creation timestamp in RFC 3339 format, if recorded.
+	CreatedAt *string `json:"created_at,omitempty"`
+	// PageNumber is the 1-indexed page or slide the annotation is attached to.
+	PageNumber *uint64 `json:"page_number,omitempty"`
+	// AnchorText is the document text the annotation refers to, if any.
+	AnchorText *string `json:"anchor_text,omitempty"`
+}
+
+// LinkedFootnote is a footnote linked to the marker that references it in the
+// body text. See Footnote for footnotes in structured Djot content.
+type LinkedFootnote struct {
+	// Marker is the footnote marker as printed, e.g. "1", "*", or "†".
+	Marker string `json:"marker"`
+	// Text is the footnote text.
+	Text string `json:"text"`
+	// ReferencePage is the 1-indexed page containing the marker in the body text.
+	ReferencePage *uint64 `json:"reference_page,omitempty"`
+	// ReferenceOffset is the character offset of the marker in Content.
+	ReferenceOffset *uint64 `json:"reference_offset,omitempty"`
+	// FootnotePage is the 1-indexed page the footnote text appears on.
+	FootnotePage *uint64 `json:"footnote_page,omitempty"`
+}
+
+// CrossRefTarget identifies the kind of element a cross-reference points to.
+type CrossRefTarget string
+
+const (
+	CrossRefTargetSection  CrossRefTarget = "section"
+	CrossRefTargetFigure   CrossRefTarget = "figure"
+	CrossRefTargetTable    CrossRefTarget = "table"
+	CrossRefTargetPage     CrossRefTarget = "page"
+	CrossRefTargetFootnote CrossRefTarget = "footnote"
+)
+
+// CrossRef is a textual reference to another part of the document.
+type CrossRef struct {
+	// Text is the reference as it appears in Content, e.g. "Section 3.2".
+	Text string `json:"text"`
+	// Offset is the character offset of Text in Content.
+	Offset uint64 `json:"offset"`
+	// TargetType is the kind of element referenced.
+	TargetType CrossRefTarget `json:"target_type"`
+	// Label is the referenced number or name, e.g. "3.2" or "4".
+	Label string `json:"label"`
+	// Resolved reports whether a matching target was found in the document.
+	Resolved bool `json:"resolved"`
+	// TargetTitle is the heading or caption of the resolved target.
+	TargetTitle *string `json:"target_title,omitempty"`
+	// TargetPage is the 1-indexed page of the resolved target.
+	TargetPage *uint64 `json:"target_page,omitempty"`
+}
+
+// PageConfidenceHeatmap aggregates per-character OCR confidence for one page.
+//
+// The page is divided into a grid of Rows × Columns equally sized regions
+// (16 × 16 by default, independent of page size and DPI), so region (0, 0) is the
+// top-left corner. Each region holds the average confidence of the characters
+// whose bounding box center falls inside it.
+type PageConfidenceHeatmap struct {
+	// PageNumber is the 1-indexed page the heatmap belongs to.
+	PageNumber uint64 `json:"page_number"`
+	// Rows is the number of grid rows.
+	Rows int `json:"rows"`
+	// Columns is the number of grid columns.
+	Columns int `json:"columns"`
+	// Confidence holds the average character confidence (0.0-1.0) per region,
+	// indexed as Confidence[row][column].
+	Confidence [][]float64 `json:"confidence"`
+	// CharCounts holds the number of characters per region. Regions with a count
+	// of 0 contain no text and their confidence value carries no information.
+	CharCounts [][]int `json:"char_counts"`
+}
+
+// At returns the average confidence of the region at row and column, and false
+// when the region is out of range or contains no characters.
+func (h *PageConfidenceHeatmap) At(row, column int) (float64, bool) {
+	if row < 0 || column < 0 || row >= len(h.Confidence) || column >= len(h.Confidence[row]) {
+		return 0, false
+	}
+	if row >= len(h.CharCounts) || column >= len(h.CharCounts[row]) || h.CharCounts[row][column] == 0 {
+		return 0, false
+	}
+	return h.Confidence[row][column], true
+}
+
+// FontInfo describes a font used by a PDF document.
+//
+// Text drawn with fonts that are neither embedded nor standard often lacks a
+// usable character mapping, which is a common cause of garbled extraction; such
+// documents are good candidates for OCR.
+type FontInfo struct {
+	// Name is the font's base name with any subset prefix removed, e.g. "Helvetica-Bold".
+	Name string `json:"name"`
+	// Type is the PDF font type, e.g. "Type1", "TrueType", "Type0", or "Type3".
+	Type string `json:"type"`
+	// Embedded reports whether the font program is embedded in the document.
+	Embedded bool `json:"embedded"`
+	// Subset reports whether only a subset of the font's glyphs is embedded.
+	Subset bool `json:"subset"`
+	// Pages lists the 1-indexed pages that use the font, in ascending order.
+	Pages []uint64 `json:"pages,omitempty"`
+}
+
+// PdfCompliance summarizes structural properties of a PDF relevant to archival
+// (PDF/A) ingestion.
+type PdfCompliance struct {
+	// PdfVersion is the version from the file header or catalog, e.g. "1.7".
+	PdfVersion string `json:"pdf_version"`
+	// PdfAConformance is the PDF/A part and conformance level declared in the XMP
+	// metadata, e.g. "2b". Nil when the document does not claim PDF/A conformance.
+	PdfAConformance *string `json:"pdfa_conformance,omitempty"`
+	// ColorSpaces lists the distinct color space families used, e.g. "DeviceRGB",
+	// "ICCBased", or "Separation".
+	ColorSpaces []string `json:"color_spaces,omitempty"`
+	// HasOutputIntent reports whether the document declares an output intent,
+	// which PDF/A requires for device-dependent color.
+	HasOutputIntent bool `json:"has_output_intent"`
+	// AllFontsEmbedded reports whether every font used is embedded.
+	AllFontsEmbedded bool `json:"all_fonts_embedded"`
+	// HasJavaScript reports whether the document contains JavaScript actions.
+	HasJavaScript bool `json:"has_javascript"`
+}
+
+// OCRRegion is a word-level region whose text was chosen from several OCR backends.
+type OCRRegion struct {
+	// PageNumber is the 1-indexed page of the region.
+	PageNumber uint64 `json:"page_number"`
+	// BoundingBox is the region's position on the rendered page image, in pixels.
+	BoundingBox BoundingBox `json:"bounding_box"`
+	// Text is the text kept for the region.
+	Text string `json:"text"`
+	// Backend is the backend whose recognition was kept.
+	Backend string `json:"backend"`
+	// Confidence is the winning backend's confidence (0.0-1.0).
+	Confidence float64 `json:"confidence"`
+}
+
+// RevisionType is the kind of a tracked change.
+type RevisionType string
+
+const (
+	RevisionInsert RevisionType = "insert"
+	RevisionDelete RevisionType = "delete"
+	RevisionFormat RevisionType = "format"
+)
+
+// Revision is one tracked change in a Word document.
+type Revision struct {
+	// Type is whether text was inserted, deleted, or reformatted.
+	Type RevisionType `json:"type"`
+	// Author is the change author, if recorded.
+	Author *string `json:"author,omitempty"`
+	// Timestamp is when the change was made, in RFC 3339 format, if recorded.
+	Timestamp *string `json:"timestamp,omitempty"`
+	// Text is the inserted, deleted, or reformatted text.
+	Text string `json:"text"`
+}
+
+// ExtractionMetrics reports where the time of one extraction was spent, as
+// measured by the native core. Stages that did not run are zero.
+type ExtractionMetrics struct {
+	// TotalDuration is the wall-clock time of the whole extraction.
+	TotalDuration time.Duration
+	// OCRDuration is the time spent in OCR, including page rendering.
+	OCRDuration time.Duration
+	// ParseDuration is the time spent parsing the document format.
+	ParseDuration time.Duration
+	// PageCount is the number of pages processed.
+	PageCount int
+}
+
+// extractionMetricsJSON is the wire format of ExtractionMetrics, with durations
+// in milliseconds.
+type extractionMetricsJSON struct {
+	TotalMs   float64 `json:"total_ms"`
+	OCRMs     float64 `json:"ocr_ms"`
+	ParseMs   float64 `json:"parse_ms"`
+	PageCount int     `json:"page_count"`
+}
+
+// UnmarshalJSON decodes metrics whose durations are given in milliseconds.
+func (m *ExtractionMetrics) UnmarshalJSON(data []byte) error {
+	var wire extractionMetricsJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	*m = ExtractionMetrics{
+		TotalDuration: millisToDuration(wire.TotalMs),
+		OCRDuration:   millisToDuration(wire.OCRMs),
+		ParseDuration: millisToDuration(wire.ParseMs),
+		PageCount:     wire.PageCount,
+	}
+	return nil
+}
+
+// MarshalJSON encodes metrics in the wire format read by UnmarshalJSON.
+func (m ExtractionMetrics) MarshalJSON() ([]byte, error) {
+	return json.Marshal(extractionMetricsJSON{
+		TotalMs:   durationToMillis(m.TotalDuration),
+		OCRMs:     durationToMillis(m.OCRDuration),
+		ParseMs:   durationToMillis(m.ParseDuration),
+		PageCount: m.PageCount,
+	})
+}
+
+func millisToDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+func durationToMillis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// PageError describes a page whose extraction failed.
+type PageError struct {
+	// PageNumber is the 1-indexed number of the failed page.
+	PageNumber uint64 `json:"page_number"`
+	// Message describes the failure.
+	Message string `json:"message"`
+}
+
+// RubyAnnotation is a ruby annotation, such as the furigana reading of Japanese
+// kanji, reported when ExtractionConfig.RubyHandling is RubyHandlingSeparate.
+type RubyAnnotation struct {
+	// Base is the annotated base text, e.g. "漢字".
+	Base string `json:"base"`
+	// Ruby is the annotation text, e.g. "かんじ".
+	Ruby string `json:"ruby"`
+	// PageNumber is the 1-indexed page of the annotation, or 0 for formats
+	// without pages.
+	PageNumber uint64 `json:"page_number,omitempty"`
+	// ByteStart and ByteEnd locate the base text in result.Content.
+	ByteStart uint64 `json:"byte_start"`
+	ByteEnd   uint64 `json:"byte_end"`
+}
+
+// Chart types reported in Chart.Type.
+const (
+	ChartTypeBar  = "bar"
+	ChartTypeLine = "line"
+)
+
+// Chart is a chart whose data was reconstructed from its data labels when
+// ExtractionConfig.ChartExtraction is enabled.
+type Chart struct {
+	// PageNumber is the 1-indexed page of the chart.
+	PageNumber uint64 `json:"page_number"`
+	// Type is the kind of chart, e.g. ChartTypeBar.
+	Type string `json:"type"`
+	// Title is the chart title, if one was found.
+	Title *string `json:"title,omitempty"`
+	// Labels are the category labels along the chart's axis, in axis order.
+	Labels []string `json:"labels"`
+	// Series holds one entry per data series, each with one value per label.
+	Series []ChartSeries `json:"series"`
+	// BoundingBox is the chart's position on its page, in PDF points.
+	BoundingBox *BoundingBox `json:"bounding_box,omitempty"`
+}
+
+// ChartSeries is one data series of a Chart.
+type ChartSeries struct {
+	// Name is the series name from the chart legend, or empty without a legend.
+	Name string `json:"name,omitempty"`
+	// Values holds the value of each of the chart's Labels. A label without a
+	// data label in this series is nil.
+	Values []*float64 `json:"values"`
+}
+
+// DetectedLanguage is a language found by language detection.
+type DetectedLanguage struct {
+	// Code is the ISO 639 language code, e.g. "en" or "deu".
+	Code string `json:"code"`
+	// Confidence is the detector's confidence (0.0-1.0) in the language.
+	Confidence float64 `json:"confidence"`
+}
+
+// EmbeddingCacheStats counts the lookups in the embedding cache during an extraction.
+type EmbeddingCacheStats struct {
+	// Hits is the number of chunks whose embedding was found in the cache.
+	Hits int `json:"hits"`
+	// Misses is the number of chunks that were embedded and added to the cache.
+	Misses int `json:"misses"`
+}
+
+// HitRate returns the fraction (0.0-1.0) of lookups served from the cache, or 0
+// when there were none.
+func (s *EmbeddingCacheStats) HitRate() float64 {
+	if s == nil || s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// Keyword is a keyword found by keyword extraction.
+type Keyword struct {
+	// Text is the keyword or keyphrase.
+	Text string `json:"text"`
+	// Score is the keyword's relevance as normalized by the algorithm; higher is
+	// more relevant.
+	Score float64 `json:"score"`
+}
+
+// DocumentTypeLabel is a label of the document classification taxonomy.
+type DocumentTypeLabel string
+
+// Document types produced by document classification.
+const (
+	DocumentTypeInvoice  DocumentTypeLabel = "invoice"
+	DocumentTypeLetter   DocumentTypeLabel = "letter"
+	DocumentTypeForm     DocumentTypeLabel = "form"
+	DocumentTypeReport   DocumentTypeLabel = "report"
+	DocumentTypeResume   DocumentTypeLabel = "resume"
+	DocumentTypeAcademic DocumentTypeLabel = "academic"
+	DocumentTypeUnknown  DocumentTypeLabel = "unknown"
+)
+
+// TOCSource identifies how a table of contents entry was derived.
+type TOCSource string
+
+const (
+	TOCSourceBookmarks TOCSource = "bookmarks"
+	TOCSourceHeadings  TOCSource = "headings"
+	TOCSourceHierarchy TOCSource = "hierarchy"
+)
+
+// TOCEntry is a single entry in the document table of contents.
+type TOCEntry struct {
+	Title      string    `json:"title"`
+	Level      int       `json:"level"`
+	PageNumber *uint64   `json:"page_number,omitempty"`
+	Source     TOCSource `json:"source"`
 }
 
 // Table represents a detected table in the source document.
@@ -21,11 +510,38 @@ type Table struct {
 	Cells      [][]string `json:"cells"`
 	Markdown   string     `json:"markdown"`
 	PageNumber int        `json:"page_number"`
+	// HasHeader reports whether the first row of Cells is a header row.
+	HasHeader bool `json:"has_header"`
+	// Formulas mirrors Cells with formula strings when SpreadsheetConfig.FormulaMode is "both".
+	// Cells without a formula are empty strings.
+	Formulas [][]string `json:"formulas,omitempty"`
+	// Caption is the table's caption when caption detection is enabled.
+	Caption *string `json:"caption,omitempty"`
+	// Rows mirrors Cells with positioned cells, indexed as Rows[row][column]. It is
+	// populated for tables detected on rendered pages, e.g. by Tesseract table
+	// detection, and is nil for tables read from the document structure.
+	Rows [][]Cell `json:"rows,omitempty"`
+	// Confidence is the detection confidence (0.0-1.0) of the table, if known.
+	Confidence *float64 `json:"confidence,omitempty"`
+}
+
+// Cell is a table cell detected on a rendered page.
+type Cell struct {
+	// Text is the cell's recognized text.
+	Text string `json:"text"`
+	// BoundingBox is the cell's position on the rendered page image, in pixels.
+	BoundingBox *BoundingBox `json:"bounding_box,omitempty"`
+	// Confidence is the average OCR confidence (0.0-1.0) of the cell's text, if known.
+	Confidence *float64 `json:"confidence,omitempty"`
 }
 
 // Chunk contains chunked content plus optional embeddings and metadata.
 type Chunk struct {
-	Content   string        `json:"content"`
+	Content string `json:"content"`
+	// Embedding is the chunk's vector when ExtractionConfig.Embedding is set, and
+	// nil otherwise. Its length is the model's dimensionality, e.g.
+	// EmbeddingModelType.Dimensions for custom models, and it has unit length
+	// when EmbeddingConfig.Normalize is enabled.
 	Embedding []float32     `json:"embedding,omitempty"`
 	Metadata  ChunkMetadata `json:"metadata"`
 }
@@ -54,8 +570,30 @@ type ExtractedImage struct {
 	IsMask           bool              `json:"is_mask"`
 	Description      *string           `json:"description,omitempty"`
 	OCRResult        *ExtractionResult `json:"ocr_result,omitempty"`
+	// Caption is the figure caption when caption detection is enabled.
+	Caption *string `json:"caption,omitempty"`
+	// BoundingBox is the image's position on its page, in PDF points, for formats
+	// with page geometry. Nil when the position is unknown.
+	BoundingBox *BoundingBox `json:"bounding_box,omitempty"`
+	// Checksum is the lowercase hex SHA-256 digest of Data when
+	// ExtractionConfig.ExtractChecksums is enabled, and empty otherwise.
+	Checksum string `json:"checksum,omitempty"`
+	// Kind tells how the image is embedded in the document, such as
+	// ImageKindInline for PDF inline images. Empty when the native core does not
+	// distinguish, which is the case for formats other than PDF.
+	Kind string `json:"kind,omitempty"`
 }
 
+// Image kinds reported in ExtractedImage.Kind.
+const (
+	// ImageKindXObject is a PDF image stored as an XObject and referenced from
+	// the page content.
+	ImageKindXObject = "xobject"
+	// ImageKindInline is a PDF inline image, embedded in the page content with the
+	// BI/ID/EI operators; see WithExtractInlineImages.
+	ImageKindInline = "inline"
+)
+
 // Metadata aggregates document metadata and format-specific payloads.
 type Metadata struct {
 	Title              *string                     `json:"title,omitempty"`
@@ -72,9 +610,14 @@ type Metadata struct {
 	ImagePreprocessing *ImagePreprocessingMetadata `json:"image_preprocessing,omitempty"`
 	JSONSchema         json.RawMessage             `json:"json_schema,omitempty"`
 	Error              *ErrorMetadata              `json:"error,omitempty"`
+	Source             *string                     `json:"metadata_source,omitempty"`
 	Additional         map[string]json.RawMessage  `json:"-"`
 }
 
+// MetadataSourceInferred is reported in Metadata.Source when at least one field
+// was inferred from the content rather than read from the file.
+const MetadataSourceInferred = "inferred"
+
 // FormatMetadata represents the discriminated union of metadata formats.
 type FormatMetadata struct {
 	Type    FormatType
@@ -418,6 +961,23 @@ type PageContent struct {
 	Tables     []Table          `json:"tables,omitempty"`
 	Images     []ExtractedImage `json:"images,omitempty"`
 	Hierarchy  *PageHierarchy   `json:"hierarchy,omitempty"`
+	// SpeakerNotes holds the slide's speaker notes when PresentationConfig.IncludeSpeakerNotes is enabled.
+	SpeakerNotes *string `json:"speaker_notes,omitempty"`
+	// DetectedDPI is the input resolution of a scanned page, read from the image
+	// metadata or inferred from its pixel dimensions and page size. Nil for pages
+	// without a raster image.
+	DetectedDPI *int `json:"detected_dpi,omitempty"`
+	// Thumbnail is a rendering of the page when thumbnails were requested with
+	// WithGenerateThumbnails.
+	Thumbnail *PageThumbnail `json:"thumbnail,omitempty"`
+}
+
+// PageThumbnail is a small rendering of a page.
+type PageThumbnail struct {
+	Data   []byte `json:"data"`
+	Format string `json:"format"`
+	Width  uint32 `json:"width"`
+	Height uint32 `json:"height"`
 }
 
 // ElementType defines semantic classification for extracted elements.
@@ -0,0 +1,129 @@
+package kreuzberg
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+)
+
+// URLOptions controls how ExtractURLSync fetches documents.
+type URLOptions struct {
+	// Client is the HTTP client used for the request. Default: http.DefaultClient.
+	Client *http.Client
+}
+
+// URLOption is a functional option for configuring URLOptions.
+type URLOption func(*URLOptions)
+
+// WithHTTPClient sets the HTTP client used to fetch documents, e.g. to configure
+// proxies, TLS, timeouts, or the redirect policy.
+func WithHTTPClient(client *http.Client) URLOption {
+	return func(o *URLOptions) {
+		o.Client = client
+	}
+}
+
+// ExtractURLSync fetches the document at the HTTP(S) URL and extracts it.
+//
+// Redirects are followed according to the client's policy. The MIME type is taken
+// from the Content-Type header; when it is missing or generic, it is detected from
+// the start of the body. The body is streamed through ExtractReaderWithContext, so
+// config.MaxReaderSize limits the download size.
+//
+// Failures to fetch the document, including non-2xx responses, are reported as
+// *NetworkError; all other errors come from extraction.
+func ExtractURLSync(url string, config *ExtractionConfig, opts ...URLOption) (*ExtractionResult, error) {
+	return ExtractURLWithContext(context.Background(), url, config, opts...)
+}
+
+// ExtractURLWithContext is like ExtractURLSync but bounds the request and the
+// download of the body by ctx.
+func ExtractURLWithContext(ctx context.Context, url string, config *ExtractionConfig, opts ...URLOption) (*ExtractionResult, error) {
+	if url == "" {
+		return nil, newValidationErrorWithContext("url is required", nil, ErrorCodeValidation, nil)
+	}
+	o := &URLOptions{Client: http.DefaultClient}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.Client == nil {
+		o.Client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, newValidationErrorWithContext("invalid url: "+url, err, ErrorCodeValidation, nil)
+	}
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return nil, newNetworkErrorWithContext(url, 0, "", err, ErrorCodeIo, nil)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, newNetworkErrorWithContext(url, resp.StatusCode, fmt.Sprintf("failed to fetch %s: %s", url, resp.Status), nil, ErrorCodeIo, nil)
+	}
+
+	body := bufio.NewReaderSize(&networkReader{url: url, r: resp.Body}, mimeSniffLength)
+	mimeType := mimeTypeFromHeader(resp.Header.Get("Content-Type"))
+	if mimeType == "" {
+		head, err := body.Peek(mimeSniffLength)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+			return nil, err
+		}
+		if len(head) == 0 {
+			return nil, newValidationErrorWithContext("empty response body from "+url, nil, ErrorCodeValidation, nil)
+		}
+		mimeType = sniffMimeType(head)
+	}
+
+	return ExtractReaderWithContext(ctx, body, mimeType, config)
+}
+
+// mimeTypeFromHeader returns the media type of a Content-Type header, or "" when
+// it is missing, malformed, or too generic to select an extractor.
+func mimeTypeFromHeader(contentType string) string {
+	if contentType == "" {
+		return ""
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return ""
+	}
+	switch mediaType {
+	case "application/octet-stream", "binary/octet-stream", "application/unknown":
+		return ""
+	}
+	return mediaType
+}
+
+// sniffMimeType detects the MIME type of a body prefix, preferring the native
+// detector and falling back to net/http's content sniffing.
+func sniffMimeType(head []byte) string {
+	if detected, err := DetectMimeType(head); err == nil && detected != "" {
+		return detected
+	}
+	mediaType, _, _ := mime.ParseMediaType(http.DetectContentType(head))
+	return mediaType
+}
+
+// networkReader reports failures reading a response body as *NetworkError.
+type networkReader struct {
+	url string
+	r   io.Reader
+}
+
+func (n *networkReader) Read(p []byte) (int, error) {
+	read, err := n.r.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		var netErr *NetworkError
+		if !errors.As(err, &netErr) {
+			err = newNetworkErrorWithContext(n.url, 0, "failed to read response body from "+n.url, err, ErrorCodeIo, nil)
+		}
+	}
+	return read, err
+}
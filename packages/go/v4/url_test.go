@@ -0,0 +1,97 @@
+package kreuzberg
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExtractURLSyncStatusError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	_, err := ExtractURLSync(server.URL+"/missing.pdf", nil)
+	var netErr *NetworkError
+	if !errors.As(err, &netErr) {
+		t.Fatalf("expected NetworkError, got %T: %v", err, err)
+	}
+	if netErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", netErr.StatusCode)
+	}
+	if netErr.Kind() != ErrorKindNetwork {
+		t.Errorf("expected network kind, got %s", netErr.Kind())
+	}
+}
+
+func TestExtractURLSyncTransportError(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	_, err := ExtractURLSync(url, nil)
+	var netErr *NetworkError
+	if !errors.As(err, &netErr) {
+		t.Fatalf("expected NetworkError, got %T: %v", err, err)
+	}
+	if netErr.StatusCode != 0 || netErr.URL != url {
+		t.Errorf("unexpected error fields: %+v", netErr)
+	}
+}
+
+func TestExtractURLSyncUsesHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	called := false
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	_, _ = ExtractURLSync(server.URL, nil, WithHTTPClient(client))
+	if !called {
+		t.Error("expected custom HTTP client to be used")
+	}
+}
+
+func TestExtractURLSyncRequiresURL(t *testing.T) {
+	_, err := ExtractURLSync("", nil)
+	var valErr *ValidationError
+	if !errors.As(err, &valErr) {
+		t.Fatalf("expected ValidationError, got %T: %v", err, err)
+	}
+}
+
+func TestMimeTypeFromHeader(t *testing.T) {
+	cases := map[string]string{
+		"":                                  "",
+		"application/pdf":                   "application/pdf",
+		"text/html; charset=utf-8":          "text/html",
+		"application/octet-stream":          "",
+		"not a media type; =":               "",
+		"Application/PDF; name=\"doc.pdf\"": "application/pdf",
+	}
+	for header, want := range cases {
+		if got := mimeTypeFromHeader(header); got != want {
+			t.Errorf("mimeTypeFromHeader(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
+
+func TestNetworkReaderWrapsErrors(t *testing.T) {
+	reader := &networkReader{url: "http://example.com", r: &failingReader{}}
+	_, err := reader.Read(make([]byte, 4))
+	var netErr *NetworkError
+	if !errors.As(err, &netErr) || !strings.Contains(netErr.Error(), "example.com") {
+		t.Errorf("expected NetworkError mentioning the URL, got %v", err)
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
@@ -9,11 +9,13 @@ import "C"
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"unsafe"
 )
 
 // ValidateBinarizationMethod validates a binarization method string via FFI.
-// Valid values include "otsu", "adaptive", "sauvola", and others.
+// Valid values include BinarizationModeOtsu, BinarizationModeAdaptive,
+// BinarizationModeSauvola, and others.
 func ValidateBinarizationMethod(method string) error {
 	if method == "" {
 		return newValidationErrorWithContext("binarization method cannot be empty", nil, ErrorCodeValidation, nil)
@@ -30,7 +32,8 @@ func ValidateBinarizationMethod(method string) error {
 }
 
 // ValidateOCRBackend validates an OCR backend string via FFI.
-// Valid values include "tesseract", "easyocr", "paddleocr", and others.
+// Valid values include OCRBackendTesseract, OCRBackendEasyOCR,
+// OCRBackendPaddleOCR, and others.
 func ValidateOCRBackend(backend string) error {
 	if backend == "" {
 		return newValidationErrorWithContext("OCR backend cannot be empty", nil, ErrorCodeValidation, nil)
@@ -100,8 +103,22 @@ func ValidateTesseractOEM(oem int) error {
 	return nil
 }
 
+// ValidateTesseractConfig checks a Tesseract config as a whole: each field like
+// ValidateTesseractPSM, ValidateTesseractOEM, and ValidateConfidence, and the
+// combinations the per-field validators miss, such as setting OEM or enabling
+// table detection with a PSM that runs no recognition (0 or 2), or characters
+// that are both in TesseditCharWhitelist and TesseditCharBlacklist. All problems
+// are reported together, as by TesseractConfig.Validate. A nil config is valid.
+func ValidateTesseractConfig(cfg *TesseractConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Validate()
+}
+
 // ValidateOutputFormat validates a Tesseract output format string.
-// Valid values include "text", "markdown", "hocr", and others.
+// Valid values include "text", "markdown", "hocr", and others. Use
+// ValidateContentFormat for the content formats set with WithOutputFormat.
 func ValidateOutputFormat(format string) error {
 	if format == "" {
 		return newValidationErrorWithContext("output format cannot be empty", nil, ErrorCodeValidation, nil)
@@ -117,6 +134,20 @@ func ValidateOutputFormat(format string) error {
 	return nil
 }
 
+// ValidateContentFormat validates a content output format, as set with
+// WithOutputFormat. Valid values are the OutputFormat constants: "plain", "text",
+// "markdown", "md", "djot", and "html".
+func ValidateContentFormat(format string) error {
+	switch OutputFormat(format) {
+	case OutputFormatPlain, OutputFormatText, OutputFormatMarkdown, OutputFormatMd, OutputFormatDjot, OutputFormatHTML:
+		return nil
+	case "":
+		return newValidationErrorWithContext("output format cannot be empty", nil, ErrorCodeValidation, nil)
+	default:
+		return newValidationErrorWithContext(fmt.Sprintf("invalid output format: %s (valid: plain, text, markdown, md, djot, html)", format), nil, ErrorCodeValidation, nil)
+	}
+}
+
 // ValidateConfidence validates a confidence threshold value.
 // Confidence values must be between 0.0 and 1.0 inclusive.
 func ValidateConfidence(confidence float64) error {
@@ -137,6 +168,21 @@ func ValidateDPI(dpi int) error {
 	return nil
 }
 
+// ClampDPI coerces dpi into the range DefaultMinDPI to DefaultMaxDPI, so a DPI
+// taken from untrusted metadata can be used instead of failing ValidateDPI.
+func ClampDPI(dpi int) int {
+	return clampDPI(dpi, DefaultMinDPI, DefaultMaxDPI)
+}
+
+// clampDPI coerces dpi into the range minDPI to maxDPI, falling back to the
+// default range when the bounds do not form a valid one.
+func clampDPI(dpi, minDPI, maxDPI int) int {
+	if minDPI <= 0 || minDPI > maxDPI {
+		minDPI, maxDPI = DefaultMinDPI, DefaultMaxDPI
+	}
+	return min(max(dpi, minDPI), maxDPI)
+}
+
 // ValidateChunkingParams validates chunking configuration parameters.
 // Checks that maxChars > 0 and maxOverlap < maxChars.
 func ValidateChunkingParams(maxChars int, maxOverlap int) error {
@@ -157,6 +203,80 @@ func ValidateChunkingParams(maxChars int, maxOverlap int) error {
 	return nil
 }
 
+// ValidateSpreadsheetFormulaMode validates a spreadsheet formula mode.
+// Valid values are "value", "formula", and "both".
+func ValidateSpreadsheetFormulaMode(mode string) error {
+	switch mode {
+	case SpreadsheetFormulaModeValue, SpreadsheetFormulaModeFormula, SpreadsheetFormulaModeBoth:
+		return nil
+	case "":
+		return newValidationErrorWithContext("spreadsheet formula mode cannot be empty", nil, ErrorCodeValidation, nil)
+	default:
+		return newValidationErrorWithContext(fmt.Sprintf("invalid spreadsheet formula mode: %s (valid: value, formula, both)", mode), nil, ErrorCodeValidation, nil)
+	}
+}
+
+// ValidateColumnGapThreshold validates a column gap threshold, which must be a
+// fraction of the page width greater than 0 and at most 1.
+func ValidateColumnGapThreshold(fraction float64) error {
+	if math.IsNaN(fraction) || fraction <= 0 || fraction > 1 {
+		return newValidationErrorWithContext(fmt.Sprintf("invalid column gap threshold: %v (must be in (0, 1])", fraction), nil, ErrorCodeValidation, nil)
+	}
+	return nil
+}
+
+// ValidateOCRMergeStrategy validates an OCR merge strategy.
+// The only valid value is "best_confidence".
+func ValidateOCRMergeStrategy(strategy string) error {
+	switch strategy {
+	case OCRMergeStrategyBestConfidence:
+		return nil
+	case "":
+		return newValidationErrorWithContext("OCR merge strategy cannot be empty", nil, ErrorCodeValidation, nil)
+	default:
+		return newValidationErrorWithContext(fmt.Sprintf("invalid OCR merge strategy: %s (valid: best_confidence)", strategy), nil, ErrorCodeValidation, nil)
+	}
+}
+
+// ValidateInlineLinkStyle validates an inline link style.
+// Valid values are "url_only", "text_only", and "text_and_url".
+func ValidateInlineLinkStyle(style string) error {
+	switch style {
+	case InlineLinkStyleURLOnly, InlineLinkStyleTextOnly, InlineLinkStyleTextAndURL:
+		return nil
+	case "":
+		return newValidationErrorWithContext("inline link style cannot be empty", nil, ErrorCodeValidation, nil)
+	default:
+		return newValidationErrorWithContext(fmt.Sprintf("invalid inline link style: %s (valid: url_only, text_only, text_and_url)", style), nil, ErrorCodeValidation, nil)
+	}
+}
+
+// ValidateCacheKeyStrategy validates a cache key strategy.
+// Valid values are "content-hash" and "path+mtime".
+func ValidateCacheKeyStrategy(strategy string) error {
+	switch strategy {
+	case CacheKeyStrategyContentHash, CacheKeyStrategyPathMtime:
+		return nil
+	case "":
+		return newValidationErrorWithContext("cache key strategy cannot be empty", nil, ErrorCodeValidation, nil)
+	default:
+		return newValidationErrorWithContext(fmt.Sprintf("invalid cache key strategy: %s (valid: content-hash, path+mtime)", strategy), nil, ErrorCodeValidation, nil)
+	}
+}
+
+// ValidateRubyHandling validates a ruby handling mode.
+// Valid values are "drop", "inline", and "separate".
+func ValidateRubyHandling(mode string) error {
+	switch mode {
+	case RubyHandlingDrop, RubyHandlingInline, RubyHandlingSeparate:
+		return nil
+	case "":
+		return newValidationErrorWithContext("ruby handling mode cannot be empty", nil, ErrorCodeValidation, nil)
+	default:
+		return newValidationErrorWithContext(fmt.Sprintf("invalid ruby handling mode: %s (valid: drop, inline, separate)", mode), nil, ErrorCodeValidation, nil)
+	}
+}
+
 // GetValidBinarizationMethods returns a list of all valid binarization methods.
 func GetValidBinarizationMethods() ([]string, error) {
 	ptr := C.kreuzberg_get_valid_binarization_methods()
@@ -220,3 +340,28 @@ func GetValidTokenReductionLevels() ([]string, error) {
 	}
 	return levels, nil
 }
+
+// GetValidOutputFormats returns a list of all valid output formats, including
+// aliases such as "md". The native core exposes no list for output formats, so
+// the list is built from the OutputFormat constants; the error is always nil and
+// kept for consistency with the other GetValid functions.
+func GetValidOutputFormats() ([]string, error) {
+	return []string{
+		string(OutputFormatPlain),
+		string(OutputFormatText),
+		string(OutputFormatMarkdown),
+		string(OutputFormatMd),
+		string(OutputFormatDjot),
+		string(OutputFormatHTML),
+	}, nil
+}
+
+// GetValidResultFormats returns a list of all valid result formats. Like
+// GetValidOutputFormats, it is built from the ResultFormat constants and the
+// error is always nil.
+func GetValidResultFormats() ([]string, error) {
+	return []string{
+		string(ResultFormatUnified),
+		string(ResultFormatElementBased),
+	}, nil
+}
@@ -1,6 +1,9 @@
 package kreuzberg
 
 import (
+	"math"
+	"reflect"
+	"slices"
 	"testing"
 )
 
@@ -50,6 +53,19 @@ func TestValidateOCRBackendEmpty(t *testing.T) {
 	}
 }
 
+func TestNewOCRConfigCheckedRejectsUnknownBackend(t *testing.T) {
+	if _, err := NewOCRConfigChecked(WithOCRBackend(string(OCRBackendTesseract))); err != nil {
+		t.Fatalf("expected tesseract to be accepted, got %v", err)
+	}
+	if _, err := NewOCRConfigChecked(WithOCRBackend("tesserakt")); err == nil {
+		t.Fatalf("expected error for misspelled OCR backend")
+	}
+	config := NewExtractionConfig(WithOCR(WithOCRBackend("tesserakt")))
+	if err := validateConfigBeforeFFI(config); err == nil {
+		t.Fatalf("expected extraction to reject misspelled OCR backend")
+	}
+}
+
 func TestValidateLanguageCodeValid(t *testing.T) {
 	validCodes := []string{"en", "eng", "de", "deu", "fr", "fra"}
 	for _, code := range validCodes {
@@ -149,6 +165,19 @@ func TestValidateOutputFormatEmpty(t *testing.T) {
 	}
 }
 
+func TestValidateContentFormat(t *testing.T) {
+	for _, format := range []OutputFormat{OutputFormatPlain, OutputFormatText, OutputFormatMarkdown, OutputFormatMd, OutputFormatDjot, OutputFormatHTML} {
+		if err := ValidateContentFormat(string(format)); err != nil {
+			t.Errorf("expected valid content format %s, got error: %v", format, err)
+		}
+	}
+	for _, format := range []string{"", "hocr", "HTML"} {
+		if _, ok := ValidateContentFormat(format).(*ValidationError); !ok {
+			t.Errorf("expected ValidationError for content format %q", format)
+		}
+	}
+}
+
 func TestValidateConfidenceValid(t *testing.T) {
 	validConfidences := []float64{0.0, 0.5, 1.0}
 	for _, conf := range validConfidences {
@@ -185,6 +214,24 @@ func TestValidateDPIInvalid(t *testing.T) {
 	}
 }
 
+func TestClampDPI(t *testing.T) {
+	cases := map[int]int{-5: DefaultMinDPI, 0: DefaultMinDPI, 72: 72, 300: 300, 600: 600, 2400: DefaultMaxDPI}
+	for dpi, want := range cases {
+		if got := ClampDPI(dpi); got != want {
+			t.Errorf("ClampDPI(%d) = %d, want %d", dpi, got, want)
+		}
+	}
+
+	cfg := NewImagePreprocessingConfig(WithTargetDPIClamped(1200, 100, 400))
+	if cfg.TargetDPI == nil || *cfg.TargetDPI != 400 {
+		t.Errorf("expected target DPI clamped to 400, got %v", cfg.TargetDPI)
+	}
+	cfg = NewImagePreprocessingConfig(WithTargetDPIClamped(1200, 400, 100))
+	if cfg.TargetDPI == nil || *cfg.TargetDPI != DefaultMaxDPI {
+		t.Errorf("expected invalid bounds to fall back to the default range, got %v", cfg.TargetDPI)
+	}
+}
+
 func TestValidateChunkingParamsValid(t *testing.T) {
 	validParams := [][2]int{
 		{100, 0},
@@ -243,6 +290,24 @@ func TestGetValidLanguageCodes(t *testing.T) {
 	}
 }
 
+func TestGetValidOutputAndResultFormats(t *testing.T) {
+	outputFormats, err := GetValidOutputFormats()
+	if err != nil {
+		t.Fatalf("failed to get valid output formats: %v", err)
+	}
+	if !slices.Contains(outputFormats, string(OutputFormatMarkdown)) || !slices.Contains(outputFormats, string(OutputFormatMd)) {
+		t.Fatalf("expected markdown and its alias in output formats, got %v", outputFormats)
+	}
+
+	resultFormats, err := GetValidResultFormats()
+	if err != nil {
+		t.Fatalf("failed to get valid result formats: %v", err)
+	}
+	if !reflect.DeepEqual(resultFormats, []string{"unified", "element_based"}) {
+		t.Fatalf("unexpected result formats: %v", resultFormats)
+	}
+}
+
 func TestGetValidOCRBackends(t *testing.T) {
 	backends, err := GetValidOCRBackends()
 	if err != nil {
@@ -268,3 +333,76 @@ func TestGetValidTokenReductionLevels(t *testing.T) {
 		t.Fatalf("expected non-empty level name in list")
 	}
 }
+
+func TestValidateSpreadsheetFormulaModeValid(t *testing.T) {
+	for _, mode := range []string{"value", "formula", "both"} {
+		if err := ValidateSpreadsheetFormulaMode(mode); err != nil {
+			t.Fatalf("expected valid mode %s, got error: %v", mode, err)
+		}
+	}
+}
+
+func TestValidateSpreadsheetFormulaModeInvalid(t *testing.T) {
+	err := ValidateSpreadsheetFormulaMode("formulas")
+	if err == nil {
+		t.Fatalf("expected error for invalid formula mode")
+	}
+	if _, ok := err.(*ValidationError); !ok {
+		t.Fatalf("expected ValidationError, got %T", err)
+	}
+}
+
+func TestValidateColumnGapThreshold(t *testing.T) {
+	for _, fraction := range []float64{DefaultColumnGapThreshold, 0.2, 1} {
+		if err := ValidateColumnGapThreshold(fraction); err != nil {
+			t.Errorf("expected %v to be valid, got error: %v", fraction, err)
+		}
+	}
+	for _, fraction := range []float64{0, -0.1, 1.5, math.NaN()} {
+		if err := ValidateColumnGapThreshold(fraction); err == nil {
+			t.Errorf("expected error for %v", fraction)
+		}
+	}
+}
+
+func TestValidateConfigBeforeFFI_ColumnGapThreshold(t *testing.T) {
+	config := NewExtractionConfig(WithPdfOptions(WithColumnGapThreshold(2)))
+	if _, ok := validateConfigBeforeFFI(config).(*ValidationError); !ok {
+		t.Error("expected ValidationError for out-of-range column gap threshold")
+	}
+}
+
+func TestValidateOCRMergeStrategy(t *testing.T) {
+	if err := ValidateOCRMergeStrategy(OCRMergeStrategyBestConfidence); err != nil {
+		t.Errorf("expected best_confidence to be valid, got error: %v", err)
+	}
+	for _, strategy := range []string{"", "majority"} {
+		if _, ok := ValidateOCRMergeStrategy(strategy).(*ValidationError); !ok {
+			t.Errorf("expected ValidationError for %q", strategy)
+		}
+	}
+
+	config := NewExtractionConfig(WithOCR(
+		WithOCRBackends("tesseract", "paddleocr"),
+		WithOCRMergeStrategy("majority"),
+	))
+	if config.OCR.Backend != "tesseract" || len(config.OCR.AdditionalBackends) != 1 {
+		t.Errorf("unexpected backends: %s, %v", config.OCR.Backend, config.OCR.AdditionalBackends)
+	}
+	if _, ok := validateConfigBeforeFFI(config).(*ValidationError); !ok {
+		t.Error("expected ValidationError for unknown merge strategy")
+	}
+}
+
+func TestTypedConstantsMatchValidatedNames(t *testing.T) {
+	for _, backend := range []OCRBackend{OCRBackendTesseract, OCRBackendEasyOCR, OCRBackendPaddleOCR} {
+		if err := ValidateOCRBackend(string(backend)); err != nil {
+			t.Errorf("expected OCRBackend %q to be valid, got %v", backend, err)
+		}
+	}
+	for _, mode := range []BinarizationMode{BinarizationModeOtsu, BinarizationModeAdaptive, BinarizationModeSauvola} {
+		if err := ValidateBinarizationMethod(string(mode)); err != nil {
+			t.Errorf("expected BinarizationMode %q to be valid, got %v", mode, err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package kreuzberg
+
+import "strings"
+
+// verticalTesseractPSM is the Tesseract page segmentation mode for a single
+// uniform block of vertically aligned text.
+const verticalTesseractPSM = 5
+
+// verticalTesseractLanguages maps Tesseract languages to their models for
+// vertical writing.
+var verticalTesseractLanguages = map[string]string{
+	"jpn":     "jpn_vert",
+	"chi_sim": "chi_sim_vert",
+	"chi_tra": "chi_tra_vert",
+	"kor":     "kor_vert",
+}
+
+// verticalOCR reports whether config enables vertical text for a Tesseract OCR
+// config that needs adjusting.
+func verticalOCR(config *ExtractionConfig) bool {
+	if config.VerticalText == nil || !*config.VerticalText || config.OCR == nil {
+		return false
+	}
+	return config.OCR.Backend == "" || config.OCR.Backend == string(OCRBackendTesseract)
+}
+
+// verticalOCRConfig returns a copy of ocr set up for vertical text: vertical
+// language models and, unless set, the vertical page segmentation mode.
+func verticalOCRConfig(ocr *OCRConfig) *OCRConfig {
+	out := *ocr
+	if ocr.Language != nil {
+		language := verticalLanguages(*ocr.Language)
+		out.Language = &language
+	}
+	tesseract := TesseractConfig{}
+	if ocr.Tesseract != nil {
+		tesseract = *ocr.Tesseract
+	}
+	tesseract.Language = verticalLanguages(tesseract.Language)
+	if tesseract.PSM == nil {
+		psm := verticalTesseractPSM
+		tesseract.PSM = &psm
+	}
+	out.Tesseract = &tesseract
+	return &out
+}
+
+// verticalLanguages replaces the languages of a Tesseract language string such
+// as "jpn+eng" that have a vertical model by that model.
+func verticalLanguages(languages string) string {
+	if languages == "" {
+		return languages
+	}
+	parts := strings.Split(languages, "+")
+	for i, part := range parts {
+		if vertical, ok := verticalTesseractLanguages[part]; ok {
+			parts[i] = vertical
+		}
+	}
+	return strings.Join(parts, "+")
+}
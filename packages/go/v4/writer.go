@@ -0,0 +1,88 @@
+package kreuzberg
+
+import (
+	"bytes"
+	"io"
+	"os"
+)
+
+// utf8BOM is the UTF-8 encoding of U+FEFF, used as a byte order mark.
+var utf8BOM = []byte{0xef, 0xbb, 0xbf}
+
+// WriteOptions controls how extracted content is written by the writer APIs.
+type WriteOptions struct {
+	// UTF8BOM prefixes the output with a UTF-8 byte order mark. Defaults to false.
+	UTF8BOM bool
+}
+
+// WriteOption is a functional option for configuring WriteOptions.
+type WriteOption func(*WriteOptions)
+
+// WithUTF8BOM controls whether written text is prefixed with a UTF-8 byte order mark.
+// Some Windows consumers require the BOM, while most other tools expect plain UTF-8.
+func WithUTF8BOM(enabled bool) WriteOption {
+	return func(o *WriteOptions) {
+		o.UTF8BOM = enabled
+	}
+}
+
+// NewWriteOptions creates WriteOptions with the given options applied.
+func NewWriteOptions(opts ...WriteOption) *WriteOptions {
+	o := &WriteOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WriteContent writes the result's Content (plain, markdown, or whichever output
+// format was requested) to w and returns the number of bytes written. A content
+// that already starts with a BOM is written unchanged, so the BOM is never doubled.
+func (r *ExtractionResult) WriteContent(w io.Writer, opts ...WriteOption) (int64, error) {
+	if r == nil {
+		return 0, newValidationErrorWithContext("result cannot be nil", nil, ErrorCodeValidation, nil)
+	}
+	if w == nil {
+		return 0, newValidationErrorWithContext("writer cannot be nil", nil, ErrorCodeValidation, nil)
+	}
+
+	o := NewWriteOptions(opts...)
+	content := []byte(r.Content)
+
+	var written int64
+	if o.UTF8BOM && !bytes.HasPrefix(content, utf8BOM) {
+		n, err := w.Write(utf8BOM)
+		written += int64(n)
+		if err != nil {
+			return written, newIOErrorWithContext("failed to write byte order mark", err, ErrorCodeIo, nil)
+		}
+	}
+
+	n, err := w.Write(content)
+	written += int64(n)
+	if err != nil {
+		return written, newIOErrorWithContext("failed to write content", err, ErrorCodeIo, nil)
+	}
+	return written, nil
+}
+
+// WriteContentFile writes the result's Content to the file at path, creating or
+// truncating it. See WriteContent for the supported options.
+func (r *ExtractionResult) WriteContentFile(path string, opts ...WriteOption) error {
+	if path == "" {
+		return newValidationErrorWithContext("path is required", nil, ErrorCodeValidation, nil)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return newIOErrorWithContext("failed to create output file", err, ErrorCodeIo, nil)
+	}
+	if _, err := r.WriteContent(f, opts...); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return newIOErrorWithContext("failed to close output file", err, ErrorCodeIo, nil)
+	}
+	return nil
+}
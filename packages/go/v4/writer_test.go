@@ -0,0 +1,59 @@
+package kreuzberg
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteContent_DefaultWithoutBOM(t *testing.T) {
+	result := &ExtractionResult{Content: "# Title"}
+	var buf bytes.Buffer
+
+	n, err := result.WriteContent(&buf)
+	if err != nil {
+		t.Fatalf("WriteContent failed: %v", err)
+	}
+	if buf.String() != "# Title" || n != int64(buf.Len()) {
+		t.Errorf("unexpected output %q (%d bytes reported)", buf.String(), n)
+	}
+}
+
+func TestWriteContent_WithUTF8BOM(t *testing.T) {
+	result := &ExtractionResult{Content: "text"}
+	var buf bytes.Buffer
+
+	n, err := result.WriteContent(&buf, WithUTF8BOM(true))
+	if err != nil {
+		t.Fatalf("WriteContent failed: %v", err)
+	}
+	if buf.String() != "\ufefftext" || n != 7 {
+		t.Errorf("unexpected output %q (%d bytes reported)", buf.String(), n)
+	}
+
+	buf.Reset()
+	bomResult := &ExtractionResult{Content: "\ufefftext"}
+	if _, err := bomResult.WriteContent(&buf, WithUTF8BOM(true)); err != nil {
+		t.Fatalf("WriteContent failed: %v", err)
+	}
+	if buf.String() != "\ufefftext" {
+		t.Errorf("expected BOM not to be doubled, got %q", buf.String())
+	}
+}
+
+func TestWriteContentFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.txt")
+	result := &ExtractionResult{Content: "hello"}
+
+	if err := result.WriteContentFile(path, WithUTF8BOM(true)); err != nil {
+		t.Fatalf("WriteContentFile failed: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read output: %v", err)
+	}
+	if !bytes.Equal(data, append([]byte{0xef, 0xbb, 0xbf}, "hello"...)) {
+		t.Errorf("unexpected file content %q", data)
+	}
+}
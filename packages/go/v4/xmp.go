@@ -0,0 +1,256 @@
+package kreuzberg
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+const (
+	rdfNamespace = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmlNamespace = "http://www.w3.org/XML/1998/namespace"
+	// xmpMetaNamespace is the namespace of the x:xmpmeta wrapper element.
+	xmpMetaNamespace = "adobe:ns:meta/"
+)
+
+// xmpPrefixes maps the namespaces of common XMP schemas to their conventional
+// prefixes, which are used in XMP keys regardless of the prefixes a packet declares.
+var xmpPrefixes = map[string]string{
+	"http://purl.org/dc/elements/1.1/":            "dc",
+	"http://ns.adobe.com/xap/1.0/":                "xmp",
+	"http://ns.adobe.com/xap/1.0/rights/":         "xmpRights",
+	"http://ns.adobe.com/xap/1.0/mm/":             "xmpMM",
+	"http://ns.adobe.com/pdf/1.3/":                "pdf",
+	"http://ns.adobe.com/photoshop/1.0/":          "photoshop",
+	"http://iptc.org/std/Iptc4xmpCore/1.0/xmlns/": "Iptc4xmpCore",
+	"http://ns.adobe.com/exif/1.0/":               "exif",
+	"http://ns.adobe.com/tiff/1.0/":               "tiff",
+	"http://creativecommons.org/ns#":              "cc",
+}
+
+// xmpElementKind classifies the elements of an XMP packet.
+type xmpElementKind int
+
+const (
+	xmpOtherElement xmpElementKind = iota
+	xmpDescriptionElement
+	xmpPropertyElement
+	xmpItemElement
+)
+
+// xmpProperty collects the value of a property element while it is parsed.
+type xmpProperty struct {
+	key         string
+	text        strings.Builder
+	items       []string
+	defaultItem string
+	alt         bool
+	hasChildren bool
+}
+
+// xmpParser flattens the properties of an XMP packet into a map.
+type xmpParser struct {
+	declared   map[string]string
+	out        map[string]string
+	kinds      []xmpElementKind
+	properties []*xmpProperty
+	item       *strings.Builder
+	itemLang   string
+}
+
+// parseXMP flattens an XMP packet into a map keyed by prefixed property names,
+// such as "dc:creator" or "xmpRights:UsageTerms". Properties of nested structures
+// are keyed by their path, e.g. "Iptc4xmpCore:CreatorContactInfo/Iptc4xmpCore:CiEmailWork".
+// Language alternatives yield their "x-default" entry, or the first one, and
+// ordered and unordered arrays are joined with "; ".
+func parseXMP(packet string) (map[string]string, error) {
+	p := &xmpParser{declared: map[string]string{}, out: map[string]string{}}
+	decoder := xml.NewDecoder(strings.NewReader(packet))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			p.start(t)
+		case xml.EndElement:
+			p.end()
+		case xml.CharData:
+			p.charData(t)
+		}
+	}
+	if len(p.out) == 0 {
+		return nil, nil
+	}
+	return p.out, nil
+}
+
+// start handles the start of an element.
+func (p *xmpParser) start(e xml.StartElement) {
+	for _, attr := range e.Attr {
+		if attr.Name.Space == "xmlns" {
+			p.declared[attr.Value] = attr.Name.Local
+		}
+	}
+
+	kind := xmpOtherElement
+	switch {
+	case e.Name.Space == rdfNamespace && e.Name.Local == "Description":
+		kind = xmpDescriptionElement
+		for _, attr := range e.Attr {
+			if isXMPProperty(attr.Name) {
+				p.out[p.key(attr.Name)] = attr.Value
+			}
+		}
+	case e.Name.Space == rdfNamespace && e.Name.Local == "Alt":
+		if top := p.top(); top != nil {
+			top.alt = true
+		}
+	case e.Name.Space == rdfNamespace && e.Name.Local == "li":
+		if p.top() != nil {
+			kind = xmpItemElement
+			p.item = &strings.Builder{}
+			p.itemLang = ""
+			for _, attr := range e.Attr {
+				if attr.Name.Space == xmlNamespace && attr.Name.Local == "lang" {
+					p.itemLang = attr.Value
+				}
+			}
+		}
+	case isXMPProperty(e.Name) && p.inDescription():
+		kind = xmpPropertyElement
+		if top := p.top(); top != nil {
+			top.hasChildren = true
+		}
+		property := &xmpProperty{key: p.key(e.Name)}
+		for _, attr := range e.Attr {
+			if attr.Name.Space == rdfNamespace && attr.Name.Local == "resource" {
+				property.text.WriteString(attr.Value)
+			}
+		}
+		p.properties = append(p.properties, property)
+	}
+	p.kinds = append(p.kinds, kind)
+}
+
+// end handles the end of the innermost open element.
+func (p *xmpParser) end() {
+	if len(p.kinds) == 0 {
+		return
+	}
+	kind := p.kinds[len(p.kinds)-1]
+	p.kinds = p.kinds[:len(p.kinds)-1]
+
+	switch kind {
+	case xmpItemElement:
+		top := p.top()
+		if value := strings.TrimSpace(p.item.String()); value != "" && top != nil {
+			top.items = append(top.items, value)
+			if p.itemLang == "x-default" {
+				top.defaultItem = value
+			}
+		}
+		p.item = nil
+	case xmpPropertyElement:
+		property := p.top()
+		p.properties = p.properties[:len(p.properties)-1]
+		if property.hasChildren {
+			return
+		}
+		if value := property.value(); value != "" {
+			p.out[property.key] = value
+		}
+	}
+}
+
+// charData collects text for the current array item or property.
+func (p *xmpParser) charData(data xml.CharData) {
+	if p.item != nil {
+		p.item.Write(data)
+		return
+	}
+	if top := p.top(); top != nil {
+		top.text.Write(data)
+	}
+}
+
+// value returns the flattened value of the property.
+func (p *xmpProperty) value() string {
+	switch {
+	case len(p.items) == 0:
+		return strings.TrimSpace(p.text.String())
+	case p.alt && p.defaultItem != "":
+		return p.defaultItem
+	case p.alt:
+		return p.items[0]
+	default:
+		return strings.Join(p.items, "; ")
+	}
+}
+
+// top returns the innermost open property, or nil.
+func (p *xmpParser) top() *xmpProperty {
+	if len(p.properties) == 0 {
+		return nil
+	}
+	return p.properties[len(p.properties)-1]
+}
+
+// inDescription reports whether an rdf:Description element is open.
+func (p *xmpParser) inDescription() bool {
+	for _, kind := range p.kinds {
+		if kind == xmpDescriptionElement {
+			return true
+		}
+	}
+	return false
+}
+
+// key returns the XMP key of a property name, prefixed with the path of the open
+// properties.
+func (p *xmpParser) key(name xml.Name) string {
+	prefix, ok := xmpPrefixes[name.Space]
+	if !ok {
+		prefix, ok = p.declared[name.Space]
+	}
+	if !ok {
+		prefix = name.Space
+	}
+	key := name.Local
+	if prefix != "" {
+		key = prefix + ":" + name.Local
+	}
+	if top := p.top(); top != nil {
+		key = top.key + "/" + key
+	}
+	return key
+}
+
+// isXMPProperty reports whether name is a schema property rather than RDF,
+// XML, or namespace syntax.
+func isXMPProperty(name xml.Name) bool {
+	switch name.Space {
+	case "", "xmlns", rdfNamespace, xmlNamespace, xmpMetaNamespace:
+		return false
+	}
+	return true
+}
+
+// decodeXMP parses result.XMPPacket into result.XMP. A malformed packet is
+// reported in result.Warnings rather than failing the extraction.
+func (r *ExtractionResult) decodeXMP() {
+	if r.XMPPacket == "" {
+		return
+	}
+	xmp, err := parseXMP(r.XMPPacket)
+	if err != nil {
+		r.Warnings = append(r.Warnings, "failed to parse XMP packet: "+err.Error())
+		return
+	}
+	r.XMP = xmp
+}